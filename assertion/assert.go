@@ -14,8 +14,12 @@ import (
 // It takes a variable as a value and hold it to apply transformations and expectations to it.
 //
 // That should takes a value and return a wrapped Expectation around it.
+//
+// CheckGoroutines(opts ...GoroutineOption) snapshots running goroutines and fails at test cleanup
+// if new goroutines are still running (see NoGoroutineLeaks).
 type Assert interface {
 	That(v interface{}) Expectation
+	CheckGoroutines(opts ...GoroutineOption)
 }
 
 // Expectation interface have three roles. It changes Expectation state by setting negation, changing
@@ -42,10 +46,23 @@ type Expectation interface {
 	SliceExpectation
 	MapExpectation
 	FsExpectation
+	ErrorExpectation
+	FuncExpectation
+	ChanExpectation
+	HTTPExpectation
+	JSONExpectation
+	MarkupExpectation
 	MapTransformer
+	SliceTransformer
 	FsTransformer
+	ErrorTransformer
+	FuncTransformer
+	ChanTransformer
 	AttributeParser
 	HTTPRecorderParser
+	HTTPRequestParser
+	JSONBodyParser
+	MarkupTransformer
 	ReflectTransformer
 	ReaderTransformer
 }
@@ -126,17 +143,30 @@ func (exp *expectation) handleFailure() {
 	}
 }
 
-func runMatcher(m Matcher, v interface{}) (mr MatchResult, err error) {
+// callRecovering calls f and returns the recovered value if f panics.
+func callRecovering(f func()) (r interface{}, panicked bool) {
+	panicked = true
 	defer func() {
-		if r := recover(); r != nil {
-			if e, ok := r.(error); ok {
-				err = fmt.Errorf("[panic error occurred] %w", e)
-			} else {
-				err = fmt.Errorf("[panic error occurred] %v", r) //nolint:goerr113
-			}
+		if panicked {
+			r = recover()
 		}
 	}()
-	mr, err = m(v)
+	f()
+	panicked = false
+	return nil, panicked
+}
+
+func runMatcher(m Matcher, v interface{}) (mr MatchResult, err error) {
+	r, panicked := callRecovering(func() {
+		mr, err = m(v)
+	})
+	if panicked {
+		if e, ok := r.(error); ok {
+			err = fmt.Errorf("[panic error occurred] %w", e)
+		} else {
+			err = fmt.Errorf("[panic error occurred] %v", r) //nolint:goerr113
+		}
+	}
 	return mr, err
 }
 
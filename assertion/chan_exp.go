@@ -0,0 +1,32 @@
+package assertion
+
+import "time"
+
+// ChanExpectation interface encloses channel related expectations.
+// Channels are received using reflect.Select with a timer, no goroutine is started.
+//
+// ReceivesWithin(d time.Duration, m Matcher) check if a value matching m is received within d.
+//
+// IsClosed() check without waiting if the channel is closed. Note that a pending value is consumed.
+//
+// NeverReceives(d time.Duration) check if no value is received (and the channel is not closed) during d.
+type ChanExpectation interface {
+	ReceivesWithin(d time.Duration, m Matcher)
+	IsClosed()
+	NeverReceives(d time.Duration)
+}
+
+func (exp *expectation) ReceivesWithin(d time.Duration, m Matcher) {
+	exp.t.Helper()
+	exp.Matches(ReceivesWithin(d, m))
+}
+
+func (exp *expectation) IsClosed() {
+	exp.t.Helper()
+	exp.Matches(IsClosed())
+}
+
+func (exp *expectation) NeverReceives(d time.Duration) {
+	exp.t.Helper()
+	exp.Matches(NeverReceives(d))
+}
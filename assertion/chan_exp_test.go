@@ -0,0 +1,125 @@
+package assertion_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/elethoughts-code/goasserts/assertion"
+	mocks "github.com/elethoughts-code/goasserts/mocks/assertion"
+	"github.com/golang/mock/gomock"
+)
+
+func Test_Chan_expectations_should_pass(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+	buffered := func(values ...int) chan int {
+		c := make(chan int, len(values))
+		for _, v := range values {
+			c <- v
+		}
+		return c
+	}
+	closed := make(chan int)
+	close(closed)
+	delayed := make(chan string)
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		delayed <- "late"
+	}()
+
+	// When
+	assert.That(buffered(1)).ReceivesWithin(time.Millisecond, assertion.IsEq(1))
+	assert.That(buffered(1)).Not().ReceivesWithin(time.Millisecond, assertion.IsEq(2))
+	assert.That(make(chan int)).Not().ReceivesWithin(time.Millisecond, assertion.IsEq(1))
+	assert.That(delayed).ReceivesWithin(time.Second, assertion.IsEq("late"))
+	assert.That(closed).IsClosed()
+	assert.That(make(chan int)).Not().IsClosed()
+	assert.That((<-chan int)(closed)).IsClosed()
+	assert.That(make(chan int)).NeverReceives(time.Millisecond)
+	assert.That(buffered(1)).Not().NeverReceives(time.Millisecond)
+	assert.That(closed).Not().NeverReceives(time.Millisecond)
+
+	// Then nothing
+}
+
+func Test_Chan_expectations_should_fail(t *testing.T) {
+	// Mock preparation
+	ctrl := gomock.NewController(t)
+	buffered := make(chan int, 2)
+	buffered <- 1
+	buffered <- 2
+	closed := make(chan int)
+	close(closed)
+
+	testEntries := []struct {
+		assertFunc func(assert assertion.Assert)
+		errLog     string
+	}{
+		{
+			assertFunc: func(assert assertion.Assert) {
+				assert.That(make(chan int)).ReceivesWithin(time.Millisecond, assertion.IsEq(1))
+			},
+			errLog: "\nNo value received within 1ms",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) {
+				assert.That(closed).ReceivesWithin(time.Millisecond, assertion.IsEq(1))
+			},
+			errLog: "\nChannel is closed without value received",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) {
+				assert.That(buffered).ReceivesWithin(time.Millisecond, assertion.IsEq(3))
+			},
+			errLog: "\nReceived value do not match : 1\nValue is not equal to expectation.\nExpected : 3\nGot : 1",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) { assert.That(buffered).IsClosed() },
+			errLog:     "\nChannel should be closed. Received : 2",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) { assert.That(make(chan int)).IsClosed() },
+			errLog:     "\nChannel should be closed",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) { assert.That(closed).Not().IsClosed() },
+			errLog:     "\nChannel should not be closed",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) { assert.That(closed).NeverReceives(time.Millisecond) },
+			errLog:     "\nChannel should not receive but is closed",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) { assert.That(make(chan int)).Not().NeverReceives(time.Millisecond) },
+			errLog:     "\nChannel should receive within 1ms",
+		},
+	}
+
+	for _, entry := range testEntries {
+		// Given
+		tMock := mocks.NewMockPublicTB(ctrl)
+		assert := assertion.New(tMock)
+
+		// Expectation
+		tMock.EXPECT().Helper().AnyTimes()
+		tMock.EXPECT().Error(entry.errLog)
+
+		// When
+		entry.assertFunc(assert)
+	}
+}
+
+func Test_Chan_expectations_should_error_if_not_chan(t *testing.T) {
+	// Mock preparation
+	ctrl := gomock.NewController(t)
+	tMock := mocks.NewMockPublicTB(ctrl)
+	assert := assertion.New(tMock)
+
+	// Expectation
+	tMock.EXPECT().Helper().AnyTimes()
+	tMock.EXPECT().Fatalf("\n%s", assertion.ErrNotOfChanType.Error()).Times(2)
+
+	// When
+	assert.That("abc").IsClosed()
+	assert.That(make(chan<- int)).NeverReceives(time.Millisecond)
+}
@@ -0,0 +1,98 @@
+package assertion
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+func toRecvChan(v interface{}) (reflect.Value, bool) {
+	c := reflect.ValueOf(v)
+	if c.Kind() != reflect.Chan || c.Type().ChanDir()&reflect.RecvDir == 0 {
+		return c, false
+	}
+	return c, true
+}
+
+// receive waits at most timeout for a value from c (a zero or negative timeout does not wait at all,
+// only an already available value being received).
+// It relies on reflect.Select and a stopped timer so no goroutine is left behind.
+func receive(c reflect.Value, timeout time.Duration) (value interface{}, received bool, closed bool) {
+	cases := []reflect.SelectCase{{Dir: reflect.SelectRecv, Chan: c}}
+	if timeout <= 0 {
+		cases = append(cases, reflect.SelectCase{Dir: reflect.SelectDefault})
+	} else {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		cases = append(cases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(timer.C)})
+	}
+	chosen, v, ok := reflect.Select(cases)
+	switch {
+	case chosen != 0:
+		return nil, false, false
+	case !ok:
+		return nil, false, true
+	default:
+		return v.Interface(), true, false
+	}
+}
+
+func ReceivesWithin(d time.Duration, m Matcher) Matcher {
+	return func(v interface{}) (MatchResult, error) {
+		c, ok := toRecvChan(v)
+		if !ok {
+			return errored(ErrNotOfChanType)
+		}
+		value, received, closed := receive(c, d)
+		switch {
+		case closed:
+			return falsy("\nChannel is closed without value received")
+		case !received:
+			return falsy(fmt.Sprintf("\nNo value received within %v", d))
+		}
+		mr, err := runMatcher(m, value)
+		if err != nil {
+			return errored(err)
+		}
+		if mr.Matches {
+			return truthy(fmt.Sprintf("\nReceived value should not match : %v", value) + mr.NLog)
+		}
+		return falsy(fmt.Sprintf("\nReceived value do not match : %v", value) + mr.Log)
+	}
+}
+
+func IsClosed() Matcher {
+	return func(v interface{}) (MatchResult, error) {
+		c, ok := toRecvChan(v)
+		if !ok {
+			return errored(ErrNotOfChanType)
+		}
+		value, received, closed := receive(c, -1)
+		switch {
+		case closed:
+			return truthy("\nChannel should not be closed")
+		case received:
+			return falsy(fmt.Sprintf("\nChannel should be closed. Received : %v", value))
+		default:
+			return falsy("\nChannel should be closed")
+		}
+	}
+}
+
+func NeverReceives(d time.Duration) Matcher {
+	return func(v interface{}) (MatchResult, error) {
+		c, ok := toRecvChan(v)
+		if !ok {
+			return errored(ErrNotOfChanType)
+		}
+		value, received, closed := receive(c, d)
+		switch {
+		case closed:
+			return falsy("\nChannel should not receive but is closed")
+		case received:
+			return falsy(fmt.Sprintf("\nChannel should not receive within %v. Received : %v", d, value))
+		default:
+			return truthy(fmt.Sprintf("\nChannel should receive within %v", d))
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package assertion
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// ChanTransformer interface encloses channel related transformations.
+// All transformations return the same expectation interface to pile in calls (Fluent API).
+//
+// Receives(timeout time.Duration) changes value to the next value received from the channel.
+// It panics if nothing is received within timeout or if the channel is closed.
+//
+// ReceivesAll(n int, timeout time.Duration) changes value to the slice of the n next received values.
+// It panics if the n values are not all received within timeout or if the channel is closed before.
+//
+// BufferedLen() changes value to the number of values queued into the channel buffer.
+type ChanTransformer interface {
+	Receives(timeout time.Duration) Expectation
+	ReceivesAll(n int, timeout time.Duration) Expectation
+	BufferedLen() Expectation
+}
+
+func recvChanOrPanic(v interface{}) reflect.Value {
+	c, ok := toRecvChan(v)
+	if !ok {
+		panic("[type error] value should be a receivable channel")
+	}
+	return c
+}
+
+func (exp *expectation) Receives(timeout time.Duration) Expectation {
+	c := recvChanOrPanic(exp.v)
+	value, received, closed := receive(c, timeout)
+	switch {
+	case closed:
+		panic("channel is closed")
+	case !received:
+		panic(fmt.Sprintf("no value received within %v", timeout))
+	}
+	exp.v = value
+	return exp
+}
+
+func (exp *expectation) ReceivesAll(n int, timeout time.Duration) Expectation {
+	c := recvChanOrPanic(exp.v)
+	deadline := time.Now().Add(timeout)
+	values := make([]interface{}, 0, n)
+	for len(values) < n {
+		value, received, closed := receive(c, time.Until(deadline))
+		switch {
+		case closed:
+			panic(fmt.Sprintf("channel is closed after %d value(s) received : %v", len(values), values))
+		case !received:
+			panic(fmt.Sprintf("%d value(s) received within %v instead of %d : %v", len(values), timeout, n, values))
+		}
+		values = append(values, value)
+	}
+	exp.v = values
+	return exp
+}
+
+func (exp *expectation) BufferedLen() Expectation {
+	c := reflect.ValueOf(exp.v)
+	if c.Kind() != reflect.Chan {
+		panic("[type error] value should be a channel")
+	}
+	exp.v = c.Len()
+	return exp
+}
@@ -0,0 +1,90 @@
+package assertion_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/elethoughts-code/goasserts/assertion"
+)
+
+func Test_Chan_transformers_should_pass_assertions(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+	c := make(chan int, 3)
+	c <- 1
+	c <- 2
+	c <- 3
+	produced := make(chan string)
+	go func() {
+		for _, s := range []string{"a", "b"} {
+			produced <- s
+		}
+	}()
+
+	// When
+	assert.That(c).BufferedLen().IsEq(3)
+	assert.That(c).Receives(time.Millisecond).IsEq(1)
+	assert.That(c).ReceivesAll(2, time.Millisecond).IsDeepEq([]interface{}{2, 3})
+	assert.That(c).BufferedLen().IsEq(0)
+	assert.That(produced).ReceivesAll(2, time.Second).IsDeepEq([]interface{}{"a", "b"})
+
+	// Then nothing
+}
+
+func Test_Chan_transformers_should_receive_ready_values_without_timeout(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+	c := make(chan int, 100)
+
+	// When
+	for i := 0; i < 100; i++ {
+		c <- i
+		assert.That(c).Receives(0).IsEq(i)
+	}
+	for i := 0; i < 100; i++ {
+		c <- i
+	}
+
+	// Then
+	assert.That(c).ReceivesAll(100, 0).HasLen(100)
+	assert.That(c).NeverReceives(0)
+}
+
+func Test_Receives_panic_on_timeout(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+
+	// When / Then
+	defer func() {
+		r := recover()
+		assert.That(r).IsEq("no value received within 1ms")
+	}()
+	assert.That(make(chan int)).Receives(time.Millisecond)
+}
+
+func Test_ReceivesAll_panic_if_closed(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+	c := make(chan int, 1)
+	c <- 1
+	close(c)
+
+	// When / Then
+	defer func() {
+		r := recover()
+		assert.That(r).IsEq("channel is closed after 1 value(s) received : [1]")
+	}()
+	assert.That(c).ReceivesAll(2, time.Millisecond)
+}
+
+func Test_Chan_transformers_panic_if_not_chan(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+
+	// When / Then
+	defer func() {
+		r := recover()
+		assert.That(r).IsEq("[type error] value should be a receivable channel")
+	}()
+	assert.That("abc").Receives(time.Millisecond)
+}
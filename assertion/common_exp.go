@@ -2,6 +2,8 @@ package assertion
 
 import (
 	"reflect"
+
+	"github.com/elethoughts-code/goasserts/diff"
 )
 
 // CommonExpectation interface hold commonly used expectations.
@@ -20,14 +22,30 @@ import (
 //
 // NoDiff(e interface{}) uses  diff.Diffs(v, e) to check equality. When the expectation fails,
 // it log the deltas detected between the value and the expectation.
+// NoDiffWith(e interface{}, opts ...diff.DiffOption) limits them (eg. with diff.MaxDiffs(10)).
+//
+// SimilarWith(e interface{}, opts ...diff.SimilarOption) and SimilarFromJSONWith(e string, opts ...diff.SimilarOption)
+// use diff.SimilarWith(v, e, opts...) to check similarity (eg. with diff.UnorderedAt("$.items")).
+//
+// ContainsSimilar(e interface{}, opts ...diff.SimilarOption) and ContainsJSON(e string, opts ...diff.SimilarOption)
+// use diff.SimilarSubset(v, e, opts...) to check that value contains at least the expectation keys.
+//
+// Expectation placeholder strings (eg. "{{any}}", "{{uuid}}", "{{capture:id}}") are only resolved with the
+// diff.WithPlaceholders option (eg. diff.WithPlaceholders(diff.DefaultPlaceholders)), captured values being
+// stored by the diff.WithCaptures option.
 type CommonExpectation interface {
 	Matches(m Matcher)
 	IsEq(e interface{})
 	IsDeepEq(e interface{})
 	NoDiff(e interface{})
+	NoDiffWith(e interface{}, opts ...diff.DiffOption)
 	Similar(e interface{})
 	SimilarUnordered(e interface{})
 	SimilarFromJSON(e string)
+	SimilarWith(e interface{}, opts ...diff.SimilarOption)
+	SimilarFromJSONWith(e string, opts ...diff.SimilarOption)
+	ContainsSimilar(e interface{}, opts ...diff.SimilarOption)
+	ContainsJSON(e string, opts ...diff.SimilarOption)
 	IsNil()
 	HaveKind(k reflect.Kind)
 	IsError(target error)
@@ -49,6 +67,11 @@ func (exp *expectation) NoDiff(e interface{}) {
 	exp.Matches(NoDiff(e))
 }
 
+func (exp *expectation) NoDiffWith(e interface{}, opts ...diff.DiffOption) {
+	exp.t.Helper()
+	exp.Matches(NoDiffWith(e, opts...))
+}
+
 func (exp *expectation) Similar(e interface{}) {
 	exp.t.Helper()
 	exp.Matches(Similar(e, false))
@@ -59,11 +82,31 @@ func (exp *expectation) SimilarFromJSON(e string) {
 	exp.Matches(SimilarFromJSON(e, true))
 }
 
+func (exp *expectation) SimilarWith(e interface{}, opts ...diff.SimilarOption) {
+	exp.t.Helper()
+	exp.Matches(SimilarWith(e, opts...))
+}
+
+func (exp *expectation) SimilarFromJSONWith(e string, opts ...diff.SimilarOption) {
+	exp.t.Helper()
+	exp.Matches(SimilarFromJSONWith(e, opts...))
+}
+
 func (exp *expectation) SimilarUnordered(e interface{}) {
 	exp.t.Helper()
 	exp.Matches(Similar(e, true))
 }
 
+func (exp *expectation) ContainsSimilar(e interface{}, opts ...diff.SimilarOption) {
+	exp.t.Helper()
+	exp.Matches(ContainsSimilar(e, opts...))
+}
+
+func (exp *expectation) ContainsJSON(e string, opts ...diff.SimilarOption) {
+	exp.t.Helper()
+	exp.Matches(ContainsJSON(e, opts...))
+}
+
 func (exp *expectation) IsNil() {
 	exp.t.Helper()
 	exp.Matches(IsNil())
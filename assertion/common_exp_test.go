@@ -135,7 +135,7 @@ func Test_Common_Matchers_should_fail(t *testing.T) {
 			assertFunc: func(assert assertion.Assert) {
 				assert.That(errors.New("some error")).AsError(&e)
 			},
-			errLog: "\nError Value is not as the expected type",
+			errLog: "\nError Value is not as the expected type\nError chain :\n  [0] *errors.errorString : some error",
 		},
 		{
 			assertFunc: func(assert assertion.Assert) {
@@ -151,7 +151,7 @@ func Test_Common_Matchers_should_fail(t *testing.T) {
 			},
 			errLog: fmt.Sprintf("\nError Value is not of the expected type.\nExpected : %v\nGot : %v",
 				errors.New("error 2"),
-				errors.New("error 1")),
+				errors.New("error 1")) + "\nError chain :\n  [0] *errors.errorString : error 1",
 		},
 
 		{
@@ -386,6 +386,30 @@ func Test_NoDiff_should_not_pass_2(t *testing.T) {
 	assert.That(a).NoDiff(b)
 }
 
+func Test_NoDiffWith_should_summarize_truncated_diffs(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	a := make([]int, 2000)
+	b := make([]int, 2000)
+	for i := range b {
+		b[i] = 1
+	}
+
+	// Expectation
+	tMock := mocks.NewMockPublicTB(ctrl)
+	assert := assertion.New(tMock)
+	tMock.EXPECT().Helper().AnyTimes()
+	tMock.EXPECT().Error("Value have following diffs with expectation :\n" +
+		"Path [[0]] : values diff\n" +
+		"A=0\n" +
+		"B=1\n" +
+		"(showing 1 of 2,000 differences)")
+
+	// When
+	assert.That(a).NoDiffWith(b, diff.MaxDiffs(1))
+	assert.That(a).NoDiffWith(a, diff.MaxDiffs(1))
+}
+
 func Test_Similar_should_pass(t *testing.T) {
 	// Given
 	assert := assertion.New(t)
@@ -711,9 +735,9 @@ func Test_Similar_unordered_should_not_pass_3(t *testing.T) {
 	assert := assertion.New(tMock)
 	tMock.EXPECT().Helper().AnyTimes()
 	tMock.EXPECT().Error("Value have following dissimilarities with expectation :\n" +
-		"Path [] : values diff\n" +
-		"A=[{a} {b} {c}]\n" +
-		"B=[{c} {b} {d}]")
+		"Path [[0] [A]] : values diff\n" +
+		"A=a\n" +
+		"B=d")
 
 	// When
 	assert.That([]struct {
@@ -744,6 +768,35 @@ func Test_Similar_unordered_should_not_pass_4(t *testing.T) {
 	}))
 }
 
+func Test_Similar_with_assertion_matcher_should_pass(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+
+	// When
+	assert.That(map[string]interface{}{"name": "bob", "id": "u-12"}).Similar(map[string]interface{}{
+		"name": assertion.AsSimilarMatcher("HasLen", assertion.HasLen(3)),
+		"id":   diff.Regexp(`^u-\d+$`),
+	})
+}
+
+func Test_Similar_with_assertion_matcher_should_fail(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	// Expectation
+	tMock := mocks.NewMockPublicTB(ctrl)
+	assert := assertion.New(tMock)
+	tMock.EXPECT().Helper().AnyTimes()
+	tMock.EXPECT().Error("Value have following dissimilarities with expectation :\n" +
+		"Path [[name]] : Matcher IsEq failed for value : bob\n" +
+		"Value is not equal to expectation.\nExpected : alice\nGot : bob")
+
+	// When
+	assert.That(map[string]interface{}{"name": "bob"}).Similar(map[string]interface{}{
+		"name": assertion.AsSimilarMatcher("IsEq", assertion.IsEq("alice")),
+	})
+}
+
 func Test_Similar_from_json_should_pass(t *testing.T) {
 	// Given
 	assert := assertion.New(t)
@@ -764,6 +817,78 @@ func Test_Similar_from_json_should_pass(t *testing.T) {
 	}`)
 }
 
+func Test_Similar_from_json_with_arrays_of_objects_should_pass(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+	type Item struct {
+		ID   int
+		Tags []string
+	}
+
+	// When
+	assert.That([]Item{{1, []string{"a"}}, {2, []string{"b", "c"}}}).SimilarFromJSON(`[
+		{"ID": 2, "Tags": ["c", "b"]},
+		{"ID": 1, "Tags": ["a"]}
+	]`)
+	assert.That(map[string][]Item{"items": {{1, nil}, {2, nil}}}).
+		SimilarWith(map[string]interface{}{"items": []Item{{2, nil}, {1, nil}}}, diff.UnorderedAt("$.items"))
+	assert.That(map[string][]Item{"items": {{1, nil}, {2, nil}}}).
+		Not().SimilarFromJSONWith(`{"items": [{"ID": 2, "Tags": null}, {"ID": 1, "Tags": null}]}`)
+}
+
+func Test_Similar_from_json_with_placeholders_should_pass(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+	type User struct {
+		ID        string
+		Age       int
+		CreatedAt string
+	}
+	user := User{ID: "123e4567-e89b-12d3-a456-426614174000", Age: 32, CreatedAt: "2021-03-04T10:20:30Z"}
+	placeholders := diff.WithPlaceholders(diff.DefaultPlaceholders)
+	captures := diff.NewCaptures()
+
+	// When
+	assert.That(user).SimilarFromJSONWith(
+		`{"ID": "{{capture:userID}}", "Age": "{{number>0}}", "CreatedAt": "{{rfc3339}}"}`,
+		placeholders, diff.WithCaptures(captures))
+	assert.That(user).ContainsJSON(`{"ID": "{{uuid}}", "Name": "{{optional:string}}"}`, placeholders)
+	assert.That(user).Not().SimilarFromJSONWith(`{"ID": "{{uuid}}", "Age": "{{number<0}}", "CreatedAt": "{{any}}"}`,
+		placeholders)
+
+	// Then
+	id, _ := captures.Get("userID")
+	assert.That(id).IsEq(user.ID)
+}
+
+func Test_Similar_from_json_with_placeholders_should_fail(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	// Expectation
+	tMock := mocks.NewMockPublicTB(ctrl)
+	assert := assertion.New(tMock)
+	tMock.EXPECT().Helper().AnyTimes()
+	tMock.EXPECT().Error("Value have following dissimilarities with expectation :\n" +
+		"Path [[A]] : Matcher number>0 failed for value : -1\n" +
+		"Path [[B]] : invalid placeholder {{unknown}} : unknown placeholder : unknown")
+
+	// When
+	assert.That(map[string]int{"A": -1, "B": 1}).SimilarFromJSONWith(`{"A": "{{number>0}}", "B": "{{unknown}}"}`,
+		diff.WithPlaceholders(diff.DefaultPlaceholders))
+}
+
+func Test_Similar_from_json_should_compare_placeholders_literally_by_default(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+	value := map[string]string{"template": "{{name}}", "id": "1"}
+
+	// Then
+	assert.That(value).SimilarFromJSON(`{"template": "{{name}}", "id": "1"}`)
+	assert.That(value).ContainsJSON(`{"template": "{{name}}"}`)
+	assert.That(value).Not().ContainsJSON(`{"id": "{{any}}", "template": "{{any}}"}`)
+}
+
 func Test_Similar_from_json_should_fail_when_bad_json(t *testing.T) {
 	// Given
 	ctrl := gomock.NewController(t)
@@ -777,3 +902,46 @@ func Test_Similar_from_json_should_fail_when_bad_json(t *testing.T) {
 	// When
 	assert.That("a").SimilarFromJSON(`a`)
 }
+
+func Test_ContainsSimilar_should_pass(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+	type Item struct {
+		ID   int
+		Name string
+		Tags []string
+	}
+	response := map[string]interface{}{
+		"total": 2,
+		"items": []Item{{1, "a", []string{"x", "y"}}, {2, "b", nil}},
+	}
+
+	// When
+	assert.That(response).ContainsSimilar(map[string]interface{}{"total": 2})
+	assert.That(Item{1, "a", nil}).ContainsSimilar(map[string]interface{}{"Name": "a"})
+	assert.That(response).Not().ContainsSimilar(map[string]interface{}{"total": 3})
+	assert.That(response).ContainsJSON(`{"items": [{"ID": 1}, {"Name": "b"}]}`)
+	assert.That(response).ContainsJSON(`{"items": [{"Name": "b"}]}`, diff.SubsetArrays())
+	assert.That(response).ContainsJSON(`{"items": [{"Tags": ["y"]}]}`, diff.SubsetArrays())
+	assert.That(response).Not().ContainsJSON(`{"items": [{"Name": "c"}]}`, diff.SubsetArrays())
+}
+
+func Test_ContainsSimilar_should_fail(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	tMock := mocks.NewMockPublicTB(ctrl)
+	assert := assertion.New(tMock)
+
+	// Expectation
+	tMock.EXPECT().Helper().AnyTimes()
+	tMock.EXPECT().Error("\nValue do not contain expectation :" +
+		"\nPath [[name]] : values diff\nA=bob\nB=alice" +
+		"\nPath [[id]] : key [id] not found")
+	tMock.EXPECT().Error("\nValue should not contain expectation")
+	tMock.EXPECT().Fatalf("\n%s", "unexpected end of JSON input")
+
+	// When
+	assert.That(map[string]string{"name": "bob", "city": "Paris"}).ContainsJSON(`{"name": "alice", "id": 1}`)
+	assert.That(map[string]string{"name": "bob", "city": "Paris"}).Not().ContainsJSON(`{"name": "bob"}`)
+	assert.That(map[string]string{}).ContainsJSON(`{`)
+}
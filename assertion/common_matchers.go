@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/elethoughts-code/goasserts/diff"
 )
@@ -71,8 +72,15 @@ func IsDeepEq(e interface{}) Matcher {
 }
 
 func Similar(e interface{}, checkUnordered bool) Matcher {
+	if checkUnordered {
+		return SimilarWith(e, diff.Unordered())
+	}
+	return SimilarWith(e)
+}
+
+func SimilarWith(e interface{}, opts ...diff.SimilarOption) Matcher {
 	return func(v interface{}) (MatchResult, error) {
-		diffs := diff.Similar(v, e, checkUnordered)
+		diffs := diff.SimilarWith(v, e, opts...)
 		if len(diffs) == 0 {
 			return truthy("Value should be similar to expectation")
 		}
@@ -85,17 +93,70 @@ func Similar(e interface{}, checkUnordered bool) Matcher {
 }
 
 func SimilarFromJSON(e string, checkUnordered bool) Matcher {
+	if checkUnordered {
+		return SimilarFromJSONWith(e, diff.Unordered())
+	}
+	return SimilarFromJSONWith(e)
+}
+
+func SimilarFromJSONWith(e string, opts ...diff.SimilarOption) Matcher {
 	return func(v interface{}) (MatchResult, error) {
 		var parsed interface{}
 		if err := json.Unmarshal([]byte(e), &parsed); err != nil {
 			return errored(err)
 		}
-		return Similar(parsed, checkUnordered)(v)
+		return SimilarWith(parsed, opts...)(v)
+	}
+}
+
+// ContainsSimilar is SimilarWith in subset mode (diff.Subset) : only keys present on the expectation are compared.
+// Use the diff.SubsetArrays option to match slices as subsets too.
+func ContainsSimilar(e interface{}, opts ...diff.SimilarOption) Matcher {
+	return func(v interface{}) (MatchResult, error) {
+		diffs := diff.SimilarSubset(v, e, opts...)
+		if len(diffs) == 0 {
+			return truthy("\nValue should not contain expectation")
+		}
+		falsyMsg := "\nValue do not contain expectation :"
+		for _, d := range diffs {
+			falsyMsg += fmt.Sprintf("\nPath %v : %v", d.Path, d.Value)
+		}
+		return falsy(falsyMsg)
 	}
 }
+
+func ContainsJSON(e string, opts ...diff.SimilarOption) Matcher {
+	return func(v interface{}) (MatchResult, error) {
+		var parsed interface{}
+		if err := json.Unmarshal([]byte(e), &parsed); err != nil {
+			return errored(err)
+		}
+		return ContainsSimilar(parsed, opts...)(v)
+	}
+}
+
+// AsSimilarMatcher adapts m to a diff.SimilarMatcher usable inside Similar expectations, m failure log
+// (or error) being reported in the resulting diff.MatcherDiff.
+// The adapter lives here as the diff package cannot depend on assertion.
+func AsSimilarMatcher(name string, m Matcher) diff.SimilarMatcher {
+	return diff.CheckMatcher(name, func(v interface{}) (bool, string) {
+		mr, err := runMatcher(m, v)
+		if err != nil {
+			return false, err.Error()
+		}
+		return mr.Matches, strings.Trim(mr.Log, "\n")
+	})
+}
+
 func NoDiff(e interface{}) Matcher {
+	return NoDiffWith(e)
+}
+
+// NoDiffWith is NoDiff configured by diff options (eg. diff.MaxDiffs(10)),
+// truncated comparisons being summarized (eg. "showing 10 of 4,231 differences").
+func NoDiffWith(e interface{}, opts ...diff.DiffOption) Matcher {
 	return func(v interface{}) (MatchResult, error) {
-		diffs := diff.Diffs(v, e)
+		diffs, stats := diff.DiffsWith(v, e, opts...)
 		if len(diffs) == 0 {
 			return truthy("Value should have diffs with expectation")
 		}
@@ -103,6 +164,9 @@ func NoDiff(e interface{}) Matcher {
 		for _, d := range diffs {
 			falsyMsg += fmt.Sprintf("\nPath %v : %v", d.Path, d.Value)
 		}
+		if stats.Truncated {
+			falsyMsg += "\n(" + stats.Summary(len(diffs)) + ")"
+		}
 		return falsy(falsyMsg)
 	}
 }
@@ -128,7 +192,8 @@ func IsError(target error) Matcher {
 			return truthy(fmt.Sprintf("\nError value should not be : %v", target))
 		}
 
-		return falsy(fmt.Sprintf("\nError Value is not of the expected type.\nExpected : %v\nGot : %v", target, ve))
+		return falsy(fmt.Sprintf("\nError Value is not of the expected type.\nExpected : %v\nGot : %v", target, ve) +
+			formatErrorChain(ve))
 	}
 }
 
@@ -143,6 +208,6 @@ func AsError(target interface{}) Matcher {
 			return truthy("\nError value should not be as expected type")
 		}
 
-		return falsy("\nError Value is not as the expected type")
+		return falsy("\nError Value is not as the expected type" + formatErrorChain(ve))
 	}
 }
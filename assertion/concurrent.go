@@ -0,0 +1,178 @@
+package assertion
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+const fatalOutsideNote = "\n[fatal failure raised outside the test goroutine, reported as an error]"
+
+// ConcurrentAssert is an Assert whose expectations can be run from worker goroutines.
+// Failures raised outside the test goroutine are queued and replayed on the test goroutine by Wait().
+//
+// Go(f) runs f on a worker goroutine tracked by the assert : Wait() blocks until every tracked goroutine
+// is done before replaying the queued failures. Wait() is also registered as a test cleanup, failures raised
+// after it (eg. by untracked goroutines outliving the test) are forwarded as is, testing reporting them loudly.
+//
+// Fatal failures raised outside the test goroutine can't stop the test from there : they are replayed
+// as errors with a note, then Wait() stops the test once every queued failure is reported.
+//
+// Note that a single Expectation (the That(v) result) still must not be shared between goroutines.
+type ConcurrentAssert interface {
+	Assert
+	Go(f func())
+	Wait()
+}
+
+// NewConcurrent is a ConcurrentAssert builder. It must be called from the test goroutine.
+func NewConcurrent(t PublicTB) ConcurrentAssert {
+	ct := &concurrentTB{
+		PublicTB: t,
+		owner:    currentGoroutineID(),
+	}
+	t.Cleanup(ct.close)
+	return &concurrentAssert{
+		assert: &assert{t: ct, br: stdBytesReader{}},
+		ct:     ct,
+	}
+}
+
+type concurrentAssert struct {
+	*assert
+	ct *concurrentTB
+}
+
+func (a *concurrentAssert) Go(f func()) {
+	a.ct.wg.Add(1)
+	go func() {
+		defer a.ct.wg.Done()
+		f()
+	}()
+}
+
+func (a *concurrentAssert) Wait() {
+	a.ct.PublicTB.Helper()
+	a.ct.Wait()
+}
+
+// concurrentTB forwards calls made from the owner goroutine and queues failures made from other goroutines.
+type concurrentTB struct {
+	PublicTB
+	owner  string
+	wg     sync.WaitGroup
+	mu     sync.Mutex
+	queue  []func()
+	fatals int
+	closed bool
+}
+
+func (ct *concurrentTB) Wait() {
+	ct.PublicTB.Helper()
+	ct.flush(false)
+}
+
+// close is the test cleanup : it waits and flushes the queue for the last time.
+func (ct *concurrentTB) close() {
+	ct.PublicTB.Helper()
+	ct.flush(true)
+}
+
+func (ct *concurrentTB) flush(closing bool) {
+	ct.PublicTB.Helper()
+	ct.wg.Wait()
+	ct.mu.Lock()
+	queue, fatals := ct.queue, ct.fatals
+	ct.queue, ct.fatals = nil, 0
+	ct.closed = ct.closed || closing
+	ct.mu.Unlock()
+	for _, replay := range queue {
+		replay()
+	}
+	if fatals > 0 {
+		ct.PublicTB.FailNow()
+	}
+}
+
+// deferred queues call and returns true when the current goroutine is not the owner one.
+func (ct *concurrentTB) deferred(call func()) bool {
+	return ct.enqueue(call, false)
+}
+
+// deferredFatal queues a fatal call converted as an error when the current goroutine is not the owner one.
+func (ct *concurrentTB) deferredFatal(call func()) bool {
+	return ct.enqueue(call, true)
+}
+
+func (ct *concurrentTB) enqueue(call func(), fatal bool) bool {
+	if currentGoroutineID() == ct.owner {
+		return false
+	}
+	ct.mu.Lock()
+	if ct.closed {
+		ct.mu.Unlock()
+		// Late failure : nothing will replay it anymore
+		call()
+		return true
+	}
+	defer ct.mu.Unlock()
+	ct.queue = append(ct.queue, call)
+	if fatal {
+		ct.fatals++
+	}
+	return true
+}
+
+func (ct *concurrentTB) Error(args ...interface{}) {
+	ct.PublicTB.Helper()
+	if !ct.deferred(func() { ct.PublicTB.Error(args...) }) {
+		ct.PublicTB.Error(args...)
+	}
+}
+
+func (ct *concurrentTB) Errorf(format string, args ...interface{}) {
+	ct.PublicTB.Helper()
+	if !ct.deferred(func() { ct.PublicTB.Errorf(format, args...) }) {
+		ct.PublicTB.Errorf(format, args...)
+	}
+}
+
+func (ct *concurrentTB) Fail() {
+	ct.PublicTB.Helper()
+	if !ct.deferred(ct.PublicTB.Fail) {
+		ct.PublicTB.Fail()
+	}
+}
+
+func (ct *concurrentTB) FailNow() {
+	ct.PublicTB.Helper()
+	if !ct.deferredFatal(func() { ct.PublicTB.Error(strings.TrimPrefix(fatalOutsideNote, "\n")) }) {
+		ct.PublicTB.FailNow()
+	}
+}
+
+func (ct *concurrentTB) Fatal(args ...interface{}) {
+	ct.PublicTB.Helper()
+	if !ct.deferredFatal(func() { ct.PublicTB.Error(fmt.Sprint(args...) + fatalOutsideNote) }) {
+		ct.PublicTB.Fatal(args...)
+	}
+}
+
+func (ct *concurrentTB) Fatalf(format string, args ...interface{}) {
+	ct.PublicTB.Helper()
+	if !ct.deferredFatal(func() { ct.PublicTB.Errorf(format+fatalOutsideNote, args...) }) {
+		ct.PublicTB.Fatalf(format, args...)
+	}
+}
+
+// currentGoroutineID returns the id of the calling goroutine as found in its stack header.
+func currentGoroutineID() string {
+	buf := make([]byte, 64)
+	buf = buf[:runtime.Stack(buf, false)]
+	header := strings.Fields(string(buf))
+	if len(header) < 2 {
+		return ""
+	}
+	return header[1]
+}
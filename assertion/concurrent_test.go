@@ -0,0 +1,138 @@
+package assertion_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/elethoughts-code/goasserts/assertion"
+	mocks "github.com/elethoughts-code/goasserts/mocks/assertion"
+	"github.com/golang/mock/gomock"
+)
+
+func Test_Concurrent_assert_should_pass_from_goroutines(t *testing.T) {
+	// Given
+	assert := assertion.NewConcurrent(t)
+	wg := sync.WaitGroup{}
+
+	// When
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			assert.That(i).IsEq(i)
+			assert.That([]int{i}).OrFatal().Contains(i)
+		}(i)
+	}
+	wg.Wait()
+	assert.Wait()
+
+	// Then nothing
+}
+
+func Test_Concurrent_assert_should_replay_failures_on_wait(t *testing.T) {
+	// Mock preparation
+	ctrl := gomock.NewController(t)
+	tMock := mocks.NewMockPublicTB(ctrl)
+	tMock.EXPECT().Helper().AnyTimes()
+	tMock.EXPECT().Cleanup(gomock.Any())
+	assert := assertion.NewConcurrent(tMock)
+	wg := sync.WaitGroup{}
+
+	// When
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.That(1).IsEq(2)
+		}()
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		assert.That(1).OrFatal().IsEq(2)
+		assert.That(1).Silent().OrFatal().IsEq(2)
+	}()
+	wg.Wait()
+
+	// Expectation (no call is forwarded before Wait)
+	tMock.EXPECT().Error("\nValue is not equal to expectation.\nExpected : 2\nGot : 1").Times(5)
+	tMock.EXPECT().Error("\nValue is not equal to expectation.\nExpected : 2\nGot : 1" +
+		"\n[fatal failure raised outside the test goroutine, reported as an error]")
+	tMock.EXPECT().Error("[fatal failure raised outside the test goroutine, reported as an error]")
+	tMock.EXPECT().FailNow()
+
+	// Then
+	assert.Wait()
+	assert.Wait()
+}
+
+func Test_Concurrent_assert_should_forward_failures_on_test_goroutine(t *testing.T) {
+	// Mock preparation
+	ctrl := gomock.NewController(t)
+	tMock := mocks.NewMockPublicTB(ctrl)
+	tMock.EXPECT().Helper().AnyTimes()
+	tMock.EXPECT().Cleanup(gomock.Any())
+	assert := assertion.NewConcurrent(tMock)
+
+	// Expectation
+	tMock.EXPECT().Error("\nValue is not equal to expectation.\nExpected : 2\nGot : 1")
+	tMock.EXPECT().Fatal("\nValue is not equal to expectation.\nExpected : 2\nGot : 1")
+
+	// When
+	assert.That(1).IsEq(2)
+	assert.That(1).OrFatal().IsEq(2)
+	assert.Wait()
+
+	// Then nothing
+}
+
+func Test_Concurrent_assert_should_wait_for_tracked_goroutines(t *testing.T) {
+	// Mock preparation
+	ctrl := gomock.NewController(t)
+	tMock := mocks.NewMockPublicTB(ctrl)
+	tMock.EXPECT().Helper().AnyTimes()
+	tMock.EXPECT().Cleanup(gomock.Any())
+	assert := assertion.NewConcurrent(tMock)
+
+	// Expectation
+	tMock.EXPECT().Error("\nValue is not equal to expectation.\nExpected : 2\nGot : 1").Times(3)
+
+	// When
+	for i := 0; i < 3; i++ {
+		assert.Go(func() {
+			time.Sleep(10 * time.Millisecond)
+			assert.That(1).IsEq(2)
+		})
+	}
+
+	// Then
+	assert.Wait()
+}
+
+func Test_Concurrent_assert_should_forward_failures_raised_after_cleanup(t *testing.T) {
+	// Mock preparation
+	ctrl := gomock.NewController(t)
+	tMock := mocks.NewMockPublicTB(ctrl)
+	tMock.EXPECT().Helper().AnyTimes()
+	var cleanup func()
+	tMock.EXPECT().Cleanup(gomock.Any()).Do(func(f func()) { cleanup = f })
+	assert := assertion.NewConcurrent(tMock)
+	cleanup()
+
+	// Expectation
+	tMock.EXPECT().Error("\nValue is not equal to expectation.\nExpected : 2\nGot : 1")
+	tMock.EXPECT().Error("\nValue is not equal to expectation.\nExpected : 2\nGot : 1" +
+		"\n[fatal failure raised outside the test goroutine, reported as an error]")
+
+	// When
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		assert.That(1).IsEq(2)
+		assert.That(1).OrFatal().IsEq(2)
+	}()
+	<-done
+
+	// Then nothing
+}
@@ -0,0 +1,30 @@
+package assertion
+
+// ErrorExpectation interface encloses error message related expectations.
+// On failure, the full unwrap chain of the error value is logged with the type of each link.
+//
+// HasErrorMessage(s string) check if the error message is equal to s.
+//
+// ErrorContains(s string) check if the error message contains s.
+//
+// ErrorMatchesRe(reg string) applies regex on the error message.
+type ErrorExpectation interface {
+	HasErrorMessage(s string)
+	ErrorContains(s string)
+	ErrorMatchesRe(reg string)
+}
+
+func (exp *expectation) HasErrorMessage(s string) {
+	exp.t.Helper()
+	exp.Matches(HasErrorMessage(s))
+}
+
+func (exp *expectation) ErrorContains(s string) {
+	exp.t.Helper()
+	exp.Matches(ErrorContains(s))
+}
+
+func (exp *expectation) ErrorMatchesRe(reg string) {
+	exp.t.Helper()
+	exp.Matches(ErrorMatchesRe(reg))
+}
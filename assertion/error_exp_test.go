@@ -0,0 +1,88 @@
+package assertion_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/elethoughts-code/goasserts/assertion"
+	mocks "github.com/elethoughts-code/goasserts/mocks/assertion"
+	"github.com/golang/mock/gomock"
+)
+
+func Test_Error_message_expectations_should_pass(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+	err := fmt.Errorf("cannot load config : %w", errors.New("file not found"))
+
+	// When
+	assert.That(err).HasErrorMessage("cannot load config : file not found")
+	assert.That(err).Not().HasErrorMessage("file not found")
+	assert.That(err).ErrorContains("file not found")
+	assert.That(err).Not().ErrorContains("permission denied")
+	assert.That(err).ErrorMatchesRe("^cannot load .* not found$")
+	assert.That(err).Not().ErrorMatchesRe("^file")
+
+	// Then nothing
+}
+
+func Test_Error_message_expectations_should_fail(t *testing.T) {
+	// Mock preparation
+	ctrl := gomock.NewController(t)
+	err := &myError{msg: "my error", wrapped: fmt.Errorf("wrapping : %w", errors.New("root"))}
+	chain := "\nError chain :" +
+		"\n  [0] *assertion_test.myError : my error" +
+		"\n  [1] *fmt.wrapError : wrapping : root" +
+		"\n  [2] *errors.errorString : root"
+
+	testEntries := []struct {
+		assertFunc func(assert assertion.Assert)
+		errLog     string
+	}{
+		{
+			assertFunc: func(assert assertion.Assert) { assert.That(err).HasErrorMessage("root") },
+			errLog:     "\nError message is not equal to expectation.\nExpected : root\nGot : my error" + chain,
+		},
+		{
+			assertFunc: func(assert assertion.Assert) { assert.That(err).ErrorContains("root") },
+			errLog:     "\nError message should contain : root\nGot : my error" + chain,
+		},
+		{
+			assertFunc: func(assert assertion.Assert) { assert.That(err).ErrorMatchesRe("root") },
+			errLog:     "\nError message do not match regexp : root\nGot : my error" + chain,
+		},
+		{
+			assertFunc: func(assert assertion.Assert) { assert.That(err).Not().ErrorContains("my") },
+			errLog:     "\nError message should not contain : my",
+		},
+	}
+
+	for _, entry := range testEntries {
+		// Given
+		tMock := mocks.NewMockPublicTB(ctrl)
+		assert := assertion.New(tMock)
+
+		// Expectation
+		tMock.EXPECT().Helper().AnyTimes()
+		tMock.EXPECT().Error(entry.errLog)
+
+		// When
+		entry.assertFunc(assert)
+	}
+}
+
+func Test_Error_message_expectations_should_fail_with_error(t *testing.T) {
+	// Mock preparation
+	ctrl := gomock.NewController(t)
+	tMock := mocks.NewMockPublicTB(ctrl)
+	assert := assertion.New(tMock)
+
+	// Expectation
+	tMock.EXPECT().Helper().AnyTimes()
+	tMock.EXPECT().Fatalf("\n%s", assertion.ErrNotOfErrorType.Error()).Times(3)
+
+	// When
+	assert.That("abc").HasErrorMessage("abc")
+	assert.That(nil).ErrorContains("abc")
+	assert.That(1).ErrorMatchesRe("abc")
+}
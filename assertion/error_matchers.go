@@ -0,0 +1,71 @@
+package assertion
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+func errorChain(err error) []error {
+	chain := make([]error, 0)
+	for err != nil {
+		chain = append(chain, err)
+		err = errors.Unwrap(err)
+	}
+	return chain
+}
+
+func formatErrorChain(err error) string {
+	log := "\nError chain :"
+	for i, link := range errorChain(err) {
+		log += fmt.Sprintf("\n  [%d] %T : %v", i, link, link)
+	}
+	return log
+}
+
+func HasErrorMessage(s string) Matcher {
+	return func(v interface{}) (MatchResult, error) {
+		ve, ok := v.(error)
+		if !ok {
+			return errored(ErrNotOfErrorType)
+		}
+		if ve.Error() == s {
+			return truthy(fmt.Sprintf("\nError message should not be : %s", s))
+		}
+		return falsy(fmt.Sprintf("\nError message is not equal to expectation.\nExpected : %s\nGot : %s", s, ve.Error()) +
+			formatErrorChain(ve))
+	}
+}
+
+func ErrorContains(s string) Matcher {
+	return func(v interface{}) (MatchResult, error) {
+		ve, ok := v.(error)
+		if !ok {
+			return errored(ErrNotOfErrorType)
+		}
+		if strings.Contains(ve.Error(), s) {
+			return truthy(fmt.Sprintf("\nError message should not contain : %s", s))
+		}
+		return falsy(fmt.Sprintf("\nError message should contain : %s\nGot : %s", s, ve.Error()) +
+			formatErrorChain(ve))
+	}
+}
+
+func ErrorMatchesRe(reg string) Matcher {
+	return func(v interface{}) (MatchResult, error) {
+		ve, ok := v.(error)
+		if !ok {
+			return errored(ErrNotOfErrorType)
+		}
+		match, err := regexp.MatchString(reg, ve.Error())
+		if err != nil {
+			return errored(err)
+		}
+		if match {
+			return truthy(fmt.Sprintf("\nError message should not match regexp : %s", reg))
+		}
+		return falsy(fmt.Sprintf("\nError message do not match regexp : %s\nGot : %s", reg, ve.Error()) +
+			formatErrorChain(ve))
+	}
+}
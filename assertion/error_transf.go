@@ -0,0 +1,83 @@
+package assertion
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ErrorTransformer interface encloses error related transformations.
+// All transformations return the same expectation interface to pile in calls (Fluent API).
+//
+// ErrorMessage() changes value to the error message.
+//
+// Unwrap() changes value to the error wrapped by the value (errors.Unwrap), nil if none.
+//
+// ErrorChain() changes value to the slice of the value error followed by all the errors it wraps.
+//
+// AsErrorType(t reflect.Type) changes value to the first error of the chain assignable to t (errors.As).
+type ErrorTransformer interface {
+	ErrorMessage() Expectation
+	Unwrap() Expectation
+	ErrorChain() Expectation
+	AsErrorType(t reflect.Type) Expectation
+}
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+func errorOrPanic(v interface{}) error {
+	err, ok := v.(error)
+	if !ok {
+		panic("[type error] value should be of type error")
+	}
+	return err
+}
+
+func (exp *expectation) ErrorMessage() Expectation {
+	if exp.v == nil {
+		return exp
+	}
+	exp.v = errorOrPanic(exp.v).Error()
+	return exp
+}
+
+func (exp *expectation) Unwrap() Expectation {
+	if exp.v == nil {
+		return exp
+	}
+	if wrapped := errors.Unwrap(errorOrPanic(exp.v)); wrapped != nil {
+		exp.v = wrapped
+	} else {
+		exp.v = nil
+	}
+	return exp
+}
+
+func (exp *expectation) ErrorChain() Expectation {
+	if exp.v == nil {
+		return exp
+	}
+	chain := errorChain(errorOrPanic(exp.v))
+	values := make([]interface{}, len(chain))
+	for i, link := range chain {
+		values[i] = link
+	}
+	exp.v = values
+	return exp
+}
+
+func (exp *expectation) AsErrorType(t reflect.Type) Expectation {
+	if exp.v == nil {
+		return exp
+	}
+	err := errorOrPanic(exp.v)
+	if t.Kind() != reflect.Interface && !t.Implements(errorType) {
+		panic(fmt.Sprintf("[type error] %v should be an interface or implement error", t))
+	}
+	target := reflect.New(t)
+	if !errors.As(err, target.Interface()) {
+		panic(fmt.Sprintf("no error of type %v found into the error chain :%s", t, formatErrorChain(err)))
+	}
+	exp.v = target.Elem().Interface()
+	return exp
+}
@@ -0,0 +1,58 @@
+package assertion_test
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/elethoughts-code/goasserts/assertion"
+)
+
+func Test_Error_transformers_should_pass_assertions(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+	root := errors.New("root")
+	wrapping := fmt.Errorf("wrapping : %w", root)
+	err := &myError{msg: "my error", wrapped: wrapping}
+
+	// When
+	assert.That(nil).ErrorMessage().IsNil()
+	assert.That(err).ErrorMessage().IsEq("my error")
+	assert.That(err).Unwrap().IsEq(wrapping)
+	assert.That(err).Unwrap().Unwrap().IsEq(root)
+	assert.That(root).Unwrap().IsNil()
+	assert.That(err).ErrorChain().ContainsExactly(err, wrapping, root)
+	assert.That(err).ErrorChain().Extract().HasLen(3)
+	assert.That(wrapping).ErrorChain().Last().ErrorMessage().IsEq("root")
+
+	assert.That(fmt.Errorf("context : %w", err)).AsErrorType(reflect.TypeOf(&myError{})).ErrorMessage().IsEq("my error")
+	assert.That(fmt.Errorf("context : %w", err)).AsErrorType(reflect.TypeOf(&myError{})).IsEq(err)
+
+	// Then nothing
+}
+
+func Test_AsErrorType_panic_if_not_found(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+
+	// When / Then
+	defer func() {
+		r := recover()
+		assert.That(r).IsEq("no error of type *assertion_test.myError found into the error chain :" +
+			"\nError chain :\n  [0] *errors.errorString : root")
+	}()
+	assert.That(errors.New("root")).AsErrorType(reflect.TypeOf(&myError{}))
+}
+
+func Test_Error_transformers_panic_if_not_error(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+
+	// When / Then
+	defer func() {
+		r := recover()
+		assert.That(r).IsEq("[type error] value should be of type error")
+	}()
+	assert.That("abc").ErrorChain()
+}
@@ -79,6 +79,30 @@ func Test_should_read_file_into_struct_as_YAML(t *testing.T) {
 	t.Cleanup(tmpFolder.Root().RemoveAll)
 }
 
+func Test_should_read_file_as_YAML_map_similar_to_struct(t *testing.T) {
+	assert := assertion.New(t)
+
+	// When
+	tmpFolder := fsBuilder.TmpDir("", "my_folder_1")
+
+	file := tmpFolder.File("file", os.O_CREATE, 0755).WriteStringDedent(`
+			Value1: hello world
+			Value2: 10
+			Value3:
+			  - a
+			  - b`)
+
+	// Then
+	assert.That(file.Name()).FileAsYAML(&map[interface{}]interface{}{}).Similar(sampleStruct{
+		Value1: "hello world",
+		Value2: 10,
+		Value3: []string{"a", "b"},
+	})
+
+	// Clean up
+	t.Cleanup(tmpFolder.Root().RemoveAll)
+}
+
 func Test_should_panic_when_reading_file_as_string_and_dont_exists(t *testing.T) {
 	assert := assertion.New(t)
 	defer func() {
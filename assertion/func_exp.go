@@ -0,0 +1,64 @@
+package assertion
+
+// FuncExpectation interface encloses func() related expectations.
+// The held function is called once by each expectation.
+//
+// Panics() check if the function panics.
+//
+// NotPanics() check if the function returns without panicking.
+//
+// PanicsWith(e interface{}) check if the function panics with a value deep equal to e.
+//
+// PanicsMatching(m Matcher) check if the function panics with a value matching m.
+//
+// Returns(e ...interface{}), ReturnsNoError() and ReturnsError(target error) apply on Call results.
+//
+// Returns(e ...interface{}) check if the results have no diffs with e.
+//
+// ReturnsNoError() check if the last result is a nil error.
+//
+// ReturnsError(target error) check if the last result is an error matching target (errors.Is).
+type FuncExpectation interface {
+	Panics()
+	NotPanics()
+	PanicsWith(e interface{})
+	PanicsMatching(m Matcher)
+	Returns(e ...interface{})
+	ReturnsNoError()
+	ReturnsError(target error)
+}
+
+func (exp *expectation) Panics() {
+	exp.t.Helper()
+	exp.Matches(Panics())
+}
+
+func (exp *expectation) NotPanics() {
+	exp.t.Helper()
+	exp.Matches(NotPanics())
+}
+
+func (exp *expectation) PanicsWith(e interface{}) {
+	exp.t.Helper()
+	exp.Matches(PanicsWith(e))
+}
+
+func (exp *expectation) PanicsMatching(m Matcher) {
+	exp.t.Helper()
+	exp.Matches(PanicsMatching(m))
+}
+
+func (exp *expectation) Returns(e ...interface{}) {
+	exp.t.Helper()
+	exp.Matches(Returns(e...))
+}
+
+func (exp *expectation) ReturnsNoError() {
+	exp.t.Helper()
+	exp.Matches(ReturnsNoError())
+}
+
+func (exp *expectation) ReturnsError(target error) {
+	exp.t.Helper()
+	exp.Matches(ReturnsError(target))
+}
@@ -0,0 +1,186 @@
+package assertion_test
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/elethoughts-code/goasserts/assertion"
+	mocks "github.com/elethoughts-code/goasserts/mocks/assertion"
+	"github.com/golang/mock/gomock"
+)
+
+func Test_Panic_expectations_should_pass(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+	errPanic := errors.New("panic error")
+
+	// When
+	assert.That(func() { panic("boom") }).Panics()
+	assert.That(func() {}).Not().Panics()
+	assert.That(func() {}).NotPanics()
+	assert.That(func() { panic("boom") }).Not().NotPanics()
+	assert.That(func() { panic("boom") }).PanicsWith("boom")
+	assert.That(func() { panic(errPanic) }).PanicsWith(errPanic)
+	assert.That(func() { panic("boom") }).Not().PanicsWith("bam")
+	assert.That(func() {}).Not().PanicsWith("boom")
+	assert.That(func() { panic("boom !") }).PanicsMatching(assertion.HasPrefix("boom"))
+	assert.That(func() { panic(errPanic) }).PanicsMatching(assertion.IsError(errPanic))
+	assert.That(func() { panic("bam") }).Not().PanicsMatching(assertion.HasPrefix("boom"))
+
+	// Then nothing
+}
+
+func Test_Panic_expectations_should_fail(t *testing.T) {
+	// Mock preparation
+	ctrl := gomock.NewController(t)
+
+	testEntries := []struct {
+		assertFunc func(assert assertion.Assert)
+		errLog     string
+	}{
+		{
+			assertFunc: func(assert assertion.Assert) { assert.That(func() {}).Panics() },
+			errLog:     "\nFunction should panic",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) { assert.That(func() { panic("boom") }).NotPanics() },
+			errLog:     "\nFunction should not panic. Recovered : boom",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) { assert.That(func() { panic("boom") }).PanicsWith("bam") },
+			errLog:     "\nFunction should panic with : bam\nRecovered : boom",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) { assert.That(func() {}).PanicsWith("bam") },
+			errLog:     "\nFunction should panic with : bam",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) {
+				assert.That(func() { panic("boom") }).PanicsMatching(assertion.IsEq("bam"))
+			},
+			errLog: "\nFunction panic value do not match. Recovered : boom" +
+				"\nValue is not equal to expectation.\nExpected : bam\nGot : boom",
+		},
+	}
+
+	for _, entry := range testEntries {
+		// Given
+		tMock := mocks.NewMockPublicTB(ctrl)
+		assert := assertion.New(tMock)
+
+		// Expectation
+		tMock.EXPECT().Helper().AnyTimes()
+		tMock.EXPECT().Error(entry.errLog)
+
+		// When
+		entry.assertFunc(assert)
+	}
+}
+
+func Test_Returns_expectations_should_pass(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+	errParse := errors.New("parse error")
+	parse := func(s string) (map[string][]int, error) {
+		if s == "" {
+			return nil, fmt.Errorf("empty input : %w", errParse)
+		}
+		return map[string][]int{s: {len(s)}}, nil
+	}
+
+	// When
+	assert.That(parse).Call("abc").Returns(map[string][]int{"abc": {3}}, nil)
+	assert.That(parse).Call("abc").Not().Returns(map[string][]int{"abc": {2}}, nil)
+	assert.That(parse).Call("abc").ReturnsNoError()
+	assert.That(parse).Call("").Not().ReturnsNoError()
+	assert.That(parse).Call("").ReturnsError(errParse)
+	assert.That(parse).Call("abc").Not().ReturnsError(errParse)
+
+	// Then nothing
+}
+
+func Test_Returns_expectations_should_fail(t *testing.T) {
+	// Mock preparation
+	ctrl := gomock.NewController(t)
+	errParse := errors.New("parse error")
+
+	testEntries := []struct {
+		assertFunc func(assert assertion.Assert)
+		errLog     string
+	}{
+		{
+			assertFunc: func(assert assertion.Assert) { assert.That(strconv.Atoi).Call("1").Returns(2, nil) },
+			errLog:     "\nFunction should return : [2 <nil>]\nGot : [1 <nil>]\nResult [0] Path [] : values diff\nA=1\nB=2",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) { assert.That(strconv.Atoi).Call("1").Returns(1) },
+			errLog:     "\nFunction should return 1 result(s), got 2 : [1 <nil>]",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) { assert.That(strings.ToUpper).Call("a").ReturnsNoError() },
+			errLog:     "\nFunction last result is not an error : A",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) {
+				assert.That(func() error { return errParse }).Call().ReturnsNoError()
+			},
+			errLog: "\nFunction should not return an error. Got : parse error" +
+				"\nError chain :\n  [0] *errors.errorString : parse error",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) {
+				assert.That(func() error { return nil }).Call().ReturnsError(errParse)
+			},
+			errLog: "\nFunction should return error : parse error\nGot : <nil>",
+		},
+	}
+
+	for _, entry := range testEntries {
+		// Given
+		tMock := mocks.NewMockPublicTB(ctrl)
+		assert := assertion.New(tMock)
+
+		// Expectation
+		tMock.EXPECT().Helper().AnyTimes()
+		tMock.EXPECT().Error(entry.errLog)
+
+		// When
+		entry.assertFunc(assert)
+	}
+}
+
+func Test_Panic_expectations_should_fail_with_error(t *testing.T) {
+	// Mock preparation
+	ctrl := gomock.NewController(t)
+	tMock := mocks.NewMockPublicTB(ctrl)
+	assert := assertion.New(tMock)
+
+	// Expectation
+	tMock.EXPECT().Helper().AnyTimes()
+	tMock.EXPECT().Fatalf("\n%s", assertion.ErrNotOfFuncType.Error()).Times(4)
+
+	// When
+	assert.That("abc").Panics()
+	assert.That(nil).NotPanics()
+	assert.That(func() error { return nil }).PanicsWith("abc")
+	assert.That(1).PanicsMatching(assertion.IsNil())
+}
+
+func Test_Returns_expectations_should_fail_with_error(t *testing.T) {
+	// Mock preparation
+	ctrl := gomock.NewController(t)
+	tMock := mocks.NewMockPublicTB(ctrl)
+	assert := assertion.New(tMock)
+
+	// Expectation
+	tMock.EXPECT().Helper().AnyTimes()
+	tMock.EXPECT().Fatalf("\n%s", assertion.ErrNoResults.Error())
+	tMock.EXPECT().Fatalf("\n%s", assertion.ErrNotOfSliceType.Error())
+
+	// When
+	assert.That(func() {}).Call().ReturnsNoError()
+	assert.That("abc").ReturnsError(errors.New("abc"))
+}
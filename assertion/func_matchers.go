@@ -0,0 +1,141 @@
+package assertion
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/elethoughts-code/goasserts/diff"
+)
+
+func Panics() Matcher {
+	return func(v interface{}) (MatchResult, error) {
+		f, ok := v.(func())
+		if !ok {
+			return errored(ErrNotOfFuncType)
+		}
+		if r, panicked := callRecovering(f); panicked {
+			return truthy(fmt.Sprintf("\nFunction should not panic. Recovered : %v", r))
+		}
+		return falsy("\nFunction should panic")
+	}
+}
+
+func NotPanics() Matcher {
+	return func(v interface{}) (MatchResult, error) {
+		f, ok := v.(func())
+		if !ok {
+			return errored(ErrNotOfFuncType)
+		}
+		if r, panicked := callRecovering(f); panicked {
+			return falsy(fmt.Sprintf("\nFunction should not panic. Recovered : %v", r))
+		}
+		return truthy("\nFunction should panic")
+	}
+}
+
+func PanicsWith(e interface{}) Matcher {
+	return func(v interface{}) (MatchResult, error) {
+		f, ok := v.(func())
+		if !ok {
+			return errored(ErrNotOfFuncType)
+		}
+		r, panicked := callRecovering(f)
+		switch {
+		case !panicked:
+			return falsy(fmt.Sprintf("\nFunction should panic with : %v", e))
+		case !reflect.DeepEqual(r, e):
+			return falsy(fmt.Sprintf("\nFunction should panic with : %v\nRecovered : %v", e, r))
+		default:
+			return truthy(fmt.Sprintf("\nFunction should not panic with : %v", e))
+		}
+	}
+}
+
+func PanicsMatching(m Matcher) Matcher {
+	return func(v interface{}) (MatchResult, error) {
+		f, ok := v.(func())
+		if !ok {
+			return errored(ErrNotOfFuncType)
+		}
+		r, panicked := callRecovering(f)
+		if !panicked {
+			return falsy("\nFunction should panic")
+		}
+		mr, err := runMatcher(m, r)
+		if err != nil {
+			return errored(err)
+		}
+		if mr.Matches {
+			return truthy(fmt.Sprintf("\nFunction should not panic with a matching value. Recovered : %v", r) + mr.NLog)
+		}
+		return falsy(fmt.Sprintf("\nFunction panic value do not match. Recovered : %v", r) + mr.Log)
+	}
+}
+
+func Returns(e ...interface{}) Matcher {
+	return func(v interface{}) (MatchResult, error) {
+		results, isSlice := toSlice(v)
+		if !isSlice {
+			return errored(ErrNotOfSliceType)
+		}
+		if len(results) != len(e) {
+			return falsy(fmt.Sprintf("\nFunction should return %d result(s), got %d : %v", len(e), len(results), results))
+		}
+		log := ""
+		for i, expected := range e {
+			for _, d := range diff.Diffs(results[i], expected) {
+				log += fmt.Sprintf("\nResult [%d] Path %v : %v", i, d.Path, d.Value)
+			}
+		}
+		if log != "" {
+			return falsy(fmt.Sprintf("\nFunction should return : %v\nGot : %v", e, results) + log)
+		}
+		return truthy(fmt.Sprintf("\nFunction should not return : %v", e))
+	}
+}
+
+func lastResult(v interface{}) (interface{}, error) {
+	results, isSlice := toSlice(v)
+	if !isSlice {
+		return nil, ErrNotOfSliceType
+	}
+	if len(results) == 0 {
+		return nil, ErrNoResults
+	}
+	return results[len(results)-1], nil
+}
+
+func ReturnsNoError() Matcher {
+	return func(v interface{}) (MatchResult, error) {
+		last, err := lastResult(v)
+		if err != nil {
+			return errored(err)
+		}
+		if last == nil {
+			return truthy("\nFunction should return an error")
+		}
+		if lastErr, ok := last.(error); ok {
+			return falsy(fmt.Sprintf("\nFunction should not return an error. Got : %v", lastErr) + formatErrorChain(lastErr))
+		}
+		return falsy(fmt.Sprintf("\nFunction last result is not an error : %v", last))
+	}
+}
+
+func ReturnsError(target error) Matcher {
+	return func(v interface{}) (MatchResult, error) {
+		last, err := lastResult(v)
+		if err != nil {
+			return errored(err)
+		}
+		lastErr, ok := last.(error)
+		if !ok {
+			return falsy(fmt.Sprintf("\nFunction should return error : %v\nGot : %v", target, last))
+		}
+		if errors.Is(lastErr, target) {
+			return truthy(fmt.Sprintf("\nFunction should not return error : %v", target))
+		}
+		return falsy(fmt.Sprintf("\nFunction should return error : %v\nGot : %v", target, lastErr) +
+			formatErrorChain(lastErr))
+	}
+}
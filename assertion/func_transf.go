@@ -0,0 +1,89 @@
+package assertion
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// FuncTransformer interface encloses func related transformations.
+// All transformations return the same expectation interface to pile in calls (Fluent API).
+//
+// Recovered() calls the held func() and changes value to the recovered panic value (nil if it did not panic).
+//
+// Call(args ...interface{}) calls the held function with args and changes value to the slice of its results.
+// Arguments are checked against the function signature (nil is accepted for nillable parameters).
+//
+// Result(i int) changes a Call results slice to its i-th result.
+type FuncTransformer interface {
+	Recovered() Expectation
+	Call(args ...interface{}) Expectation
+	Result(i int) Expectation
+}
+
+func (exp *expectation) Recovered() Expectation {
+	f, ok := exp.v.(func())
+	if !ok {
+		panic("[type error] value should be of type func()")
+	}
+	r, _ := callRecovering(f)
+	exp.v = r
+	return exp
+}
+
+func callArguments(ft reflect.Type, args []interface{}) []reflect.Value {
+	nbIn := ft.NumIn()
+	if (!ft.IsVariadic() && len(args) != nbIn) || (ft.IsVariadic() && len(args) < nbIn-1) {
+		panic(fmt.Sprintf("[type error] %v expects %d argument(s), got %d", ft, nbIn, len(args)))
+	}
+	in := make([]reflect.Value, len(args))
+	for i, arg := range args {
+		var pt reflect.Type
+		if ft.IsVariadic() && i >= nbIn-1 {
+			pt = ft.In(nbIn - 1).Elem()
+		} else {
+			pt = ft.In(i)
+		}
+		if arg == nil {
+			switch pt.Kind() {
+			case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice:
+				in[i] = reflect.Zero(pt)
+				continue
+			default:
+				panic(fmt.Sprintf("[type error] argument %d is nil but parameter of %v is of type %v", i, ft, pt))
+			}
+		}
+		av := reflect.ValueOf(arg)
+		if !av.Type().AssignableTo(pt) {
+			panic(fmt.Sprintf("[type error] argument %d of type %v is not assignable to parameter of type %v in %v",
+				i, av.Type(), pt, ft))
+		}
+		in[i] = av
+	}
+	return in
+}
+
+func (exp *expectation) Call(args ...interface{}) Expectation {
+	f := reflect.ValueOf(exp.v)
+	if f.Kind() != reflect.Func || f.IsNil() {
+		panic("[type error] value should be a function")
+	}
+	out := f.Call(callArguments(f.Type(), args))
+	results := make([]interface{}, len(out))
+	for i, o := range out {
+		results[i] = o.Interface()
+	}
+	exp.v = results
+	return exp
+}
+
+func (exp *expectation) Result(i int) Expectation {
+	results, ok := exp.v.([]interface{})
+	if !ok {
+		panic("[type error] value should be a Call results slice")
+	}
+	if i < 0 || i >= len(results) {
+		panic(fmt.Sprintf("result %d out of bound (%d result(s))", i, len(results)))
+	}
+	exp.v = results[i]
+	return exp
+}
@@ -0,0 +1,92 @@
+package assertion_test
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/elethoughts-code/goasserts/assertion"
+)
+
+func Test_Recovered_should_pass_assertions(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+	errPanic := errors.New("panic error")
+
+	// When
+	assert.That(func() { panic("boom") }).Recovered().IsEq("boom")
+	assert.That(func() { panic(errPanic) }).Recovered().IsError(errPanic)
+	assert.That(func() { panic(errPanic) }).Recovered().HasErrorMessage("panic error")
+	assert.That(func() {}).Recovered().IsNil()
+
+	// Then nothing
+}
+
+func Test_Recovered_panic_if_not_func(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+
+	// When / Then
+	defer func() {
+		r := recover()
+		assert.That(r).IsEq("[type error] value should be of type func()")
+	}()
+	assert.That("abc").Recovered()
+}
+
+func Test_Call_should_pass_assertions(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+	join := func(sep string, values ...string) string { return strings.Join(values, sep) }
+	describe := func(e error, m map[string]int) string { return fmt.Sprintf("%v %v", e, len(m)) }
+
+	// When
+	assert.That(strconv.Atoi).Call("12").Returns(12, nil)
+	assert.That(strconv.Atoi).Call("12").Result(0).IsEq(12)
+	assert.That(strconv.Atoi).Call("a").Result(1).ErrorContains("invalid syntax")
+	assert.That(join).Call(",", "a", "b").Returns("a,b")
+	assert.That(join).Call(",").Returns("")
+	assert.That(describe).Call(nil, nil).Returns("<nil> 0")
+	assert.That(func() {}).Call().IsEmpty()
+
+	// Then nothing
+}
+
+func Test_Call_panic_if_arguments_do_not_match(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+
+	testEntries := []struct {
+		callFunc func()
+		msg      string
+	}{
+		{
+			callFunc: func() { assert.That(strconv.Atoi).Call(12) },
+			msg: "[type error] argument 0 of type int is not assignable to parameter of type string" +
+				" in func(string) (int, error)",
+		},
+		{
+			callFunc: func() { assert.That(strconv.Atoi).Call() },
+			msg:      "[type error] func(string) (int, error) expects 1 argument(s), got 0",
+		},
+		{
+			callFunc: func() { assert.That(strconv.Atoi).Call(nil) },
+			msg:      "[type error] argument 0 is nil but parameter of func(string) (int, error) is of type string",
+		},
+		{
+			callFunc: func() { assert.That("abc").Call() },
+			msg:      "[type error] value should be a function",
+		},
+		{
+			callFunc: func() { assert.That(strconv.Atoi).Call("1").Result(2) },
+			msg:      "result 2 out of bound (2 result(s))",
+		},
+	}
+
+	for _, entry := range testEntries {
+		// When / Then
+		assert.That(entry.callFunc).PanicsWith(entry.msg)
+	}
+}
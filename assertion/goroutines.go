@@ -0,0 +1,150 @@
+package assertion
+
+import (
+	"fmt"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	defaultGoroutinesTimeout = time.Second
+	goroutinesPollInterval   = 10 * time.Millisecond
+)
+
+// defaultIgnoredGoroutines are stack parts of goroutines owned by the testing and runtime packages.
+var defaultIgnoredGoroutines = []string{
+	"testing.tRunner(",
+	"testing.(*M).",
+	"testing.runTests(",
+	"os/signal.signal_recv(",
+	"os/signal.loop(",
+	"runtime.ensureSigM(",
+}
+
+type goroutinesCheck struct {
+	timeout time.Duration
+	ignored []string
+}
+
+// GoroutineOption configures a goroutine leak check.
+type GoroutineOption func(c *goroutinesCheck)
+
+// GoroutinesTimeout option sets how long the check waits for goroutines to end (one second by default).
+func GoroutinesTimeout(d time.Duration) GoroutineOption {
+	return func(c *goroutinesCheck) {
+		c.timeout = d
+	}
+}
+
+// IgnoreGoroutines option ignores goroutines whose stack contains one of the given parts
+// (eg. a known-good function name like "net/http.(*persistConn).readLoop").
+func IgnoreGoroutines(stackParts ...string) GoroutineOption {
+	return func(c *goroutinesCheck) {
+		c.ignored = append(c.ignored, stackParts...)
+	}
+}
+
+type goroutine struct {
+	id    string
+	stack string
+}
+
+// NoGoroutineLeaks snapshots running goroutines and checks at test cleanup that no new goroutine is left.
+// It is a shortcut to New(t).CheckGoroutines(opts...).
+func NoGoroutineLeaks(t PublicTB, opts ...GoroutineOption) {
+	t.Helper()
+	New(t).CheckGoroutines(opts...)
+}
+
+func (a *assert) CheckGoroutines(opts ...GoroutineOption) {
+	a.t.Helper()
+	c := &goroutinesCheck{
+		timeout: defaultGoroutinesTimeout,
+		// Copied so that IgnoreGoroutines never appends to the shared defaults
+		ignored: append([]string(nil), defaultIgnoredGoroutines...),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	baseline := make(map[string]bool)
+	for _, g := range goroutines() {
+		baseline[g.id] = true
+	}
+	a.t.Cleanup(func() {
+		a.t.Helper()
+		a.That(baseline).Matches(c.noLeaks())
+	})
+}
+
+// noLeaks polls running goroutines until none is left apart from the baseline ones or timeout expires.
+func (c *goroutinesCheck) noLeaks() Matcher {
+	return func(v interface{}) (MatchResult, error) {
+		baseline := v.(map[string]bool)
+		deadline := time.Now().Add(c.timeout)
+		for {
+			leaked := c.leaked(baseline)
+			if len(leaked) == 0 {
+				return truthy("\nGoroutines should leak")
+			}
+			if time.Now().After(deadline) {
+				stacks := make([]string, len(leaked))
+				for i, g := range leaked {
+					stacks[i] = g.stack
+				}
+				return falsy(fmt.Sprintf("\nLeaked goroutines (%d) after %v :\n%s",
+					len(leaked), c.timeout, strings.Join(stacks, "\n\n")))
+			}
+			time.Sleep(goroutinesPollInterval)
+		}
+	}
+}
+
+func (c *goroutinesCheck) leaked(baseline map[string]bool) []goroutine {
+	leaked := make([]goroutine, 0)
+	for _, g := range goroutines() {
+		if baseline[g.id] || c.isIgnored(g) {
+			continue
+		}
+		leaked = append(leaked, g)
+	}
+	return leaked
+}
+
+func (c *goroutinesCheck) isIgnored(g goroutine) bool {
+	for _, part := range c.ignored {
+		if strings.Contains(g.stack, part) {
+			return true
+		}
+	}
+	return false
+}
+
+// goroutines returns the running goroutines except the current one, sorted by id.
+func goroutines() []goroutine {
+	buf := make([]byte, 64*1024)
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			buf = buf[:n]
+			break
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+	blocks := strings.Split(strings.TrimSpace(string(buf)), "\n\n")
+	result := make([]goroutine, 0, len(blocks))
+	// First block is always the current goroutine
+	for _, block := range blocks[1:] {
+		header := strings.Fields(block)
+		if len(header) < 2 || header[0] != "goroutine" {
+			continue
+		}
+		result = append(result, goroutine{id: header[1], stack: block})
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return len(result[i].id) < len(result[j].id) ||
+			len(result[i].id) == len(result[j].id) && result[i].id < result[j].id
+	})
+	return result
+}
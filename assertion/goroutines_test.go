@@ -0,0 +1,121 @@
+package assertion_test
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/elethoughts-code/goasserts/assertion"
+	mocks "github.com/elethoughts-code/goasserts/mocks/assertion"
+	"github.com/golang/mock/gomock"
+)
+
+func blockUntilClosed(c chan struct{}) {
+	<-c
+}
+
+func Test_NoGoroutineLeaks_should_pass(t *testing.T) {
+	// Given
+	assertion.NoGoroutineLeaks(t)
+	wg := sync.WaitGroup{}
+
+	// When
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			time.Sleep(time.Millisecond)
+		}()
+	}
+	wg.Wait()
+
+	// Then nothing
+}
+
+func Test_CheckGoroutines_should_wait_for_ending_goroutines(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+	assert.CheckGoroutines(assertion.GoroutinesTimeout(time.Second))
+
+	// When
+	go time.Sleep(20 * time.Millisecond)
+
+	// Then nothing
+}
+
+func Test_CheckGoroutines_should_fail_on_leaks(t *testing.T) {
+	// Mock preparation
+	ctrl := gomock.NewController(t)
+	tMock := mocks.NewMockPublicTB(ctrl)
+	assert := assertion.New(tMock)
+	var cleanup func()
+	var log string
+	stop := make(chan struct{})
+	defer close(stop)
+
+	// Expectation
+	tMock.EXPECT().Helper().AnyTimes()
+	tMock.EXPECT().Cleanup(gomock.Any()).Do(func(f func()) { cleanup = f })
+	tMock.EXPECT().Error(gomock.Any()).Do(func(args ...interface{}) { log = args[0].(string) })
+
+	// When
+	assert.CheckGoroutines(assertion.GoroutinesTimeout(20 * time.Millisecond))
+	go blockUntilClosed(stop)
+	cleanup()
+
+	// Then
+	if !strings.HasPrefix(log, "\nLeaked goroutines (1) after 20ms :\ngoroutine ") ||
+		!strings.Contains(log, "assertion_test.blockUntilClosed(") ||
+		!strings.Contains(log, "created by ") {
+		t.Errorf("unexpected leak report : %s", log)
+	}
+}
+
+func Test_CheckGoroutines_should_ignore_known_stacks(t *testing.T) {
+	// Mock preparation
+	ctrl := gomock.NewController(t)
+	tMock := mocks.NewMockPublicTB(ctrl)
+	assert := assertion.New(tMock)
+	var cleanup func()
+	stop := make(chan struct{})
+	defer close(stop)
+
+	// Expectation
+	tMock.EXPECT().Helper().AnyTimes()
+	tMock.EXPECT().Cleanup(gomock.Any()).Do(func(f func()) { cleanup = f })
+
+	// When
+	assert.CheckGoroutines(assertion.GoroutinesTimeout(20*time.Millisecond),
+		assertion.IgnoreGoroutines("assertion_test.blockUntilClosed("))
+	go blockUntilClosed(stop)
+	cleanup()
+
+	// Then nothing
+}
+
+func Test_CheckGoroutines_should_not_share_ignored_stacks(t *testing.T) {
+	// Mock preparation
+	ctrl := gomock.NewController(t)
+	tMock := mocks.NewMockPublicTB(ctrl)
+	assert := assertion.New(tMock)
+	cleanups := make([]func(), 0)
+	stop := make(chan struct{})
+	defer close(stop)
+
+	// Expectation
+	tMock.EXPECT().Helper().AnyTimes()
+	tMock.EXPECT().Cleanup(gomock.Any()).Do(func(f func()) { cleanups = append(cleanups, f) }).Times(2)
+	tMock.EXPECT().Error(gomock.Any())
+
+	// When
+	assert.CheckGoroutines(assertion.GoroutinesTimeout(20*time.Millisecond),
+		assertion.IgnoreGoroutines("assertion_test.blockUntilClosed("))
+	assert.CheckGoroutines(assertion.GoroutinesTimeout(20 * time.Millisecond))
+	go blockUntilClosed(stop)
+	for _, cleanup := range cleanups {
+		cleanup()
+	}
+
+	// Then the second check reports the leak
+}
@@ -0,0 +1,73 @@
+package assertion
+
+// HTTPExpectation interface encloses *httptest.ResponseRecorder and *http.Response expectations.
+// Failure messages are followed by the response dump (status line, headers and truncated body).
+//
+// IsSuccess(), IsRedirect(), IsClientError() and IsServerError() check the response status class
+// (2xx, 3xx, 4xx and 5xx).
+//
+// HasStatus(code int) check the response status code.
+//
+// HasContentType(mimeType string) check the response media type, ignoring parameters (eg. charset).
+//
+// RedirectsTo(location string) check if the response is a redirection to location.
+//
+// HasHeaderValues(name string, values ...string) check all the values of a (multi-valued) header.
+//
+// HasCacheControl(directive string) check if the response Cache-Control header has the directive.
+type HTTPExpectation interface {
+	IsSuccess()
+	IsRedirect()
+	IsClientError()
+	IsServerError()
+	HasStatus(code int)
+	HasContentType(mimeType string)
+	RedirectsTo(location string)
+	HasHeaderValues(name string, values ...string)
+	HasCacheControl(directive string)
+}
+
+func (exp *expectation) IsSuccess() {
+	exp.t.Helper()
+	exp.Matches(IsSuccess())
+}
+
+func (exp *expectation) IsRedirect() {
+	exp.t.Helper()
+	exp.Matches(IsRedirect())
+}
+
+func (exp *expectation) IsClientError() {
+	exp.t.Helper()
+	exp.Matches(IsClientError())
+}
+
+func (exp *expectation) IsServerError() {
+	exp.t.Helper()
+	exp.Matches(IsServerError())
+}
+
+func (exp *expectation) HasStatus(code int) {
+	exp.t.Helper()
+	exp.Matches(HasStatus(code))
+}
+
+func (exp *expectation) HasContentType(mimeType string) {
+	exp.t.Helper()
+	exp.Matches(HasContentType(mimeType))
+}
+
+func (exp *expectation) RedirectsTo(location string) {
+	exp.t.Helper()
+	exp.Matches(RedirectsTo(location))
+}
+
+func (exp *expectation) HasHeaderValues(name string, values ...string) {
+	exp.t.Helper()
+	exp.Matches(HasHeaderValues(name, values...))
+}
+
+func (exp *expectation) HasCacheControl(directive string) {
+	exp.t.Helper()
+	exp.Matches(HasCacheControl(directive))
+}
@@ -0,0 +1,180 @@
+package assertion_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/elethoughts-code/goasserts/assertion"
+	mocks "github.com/elethoughts-code/goasserts/mocks/assertion"
+	"github.com/golang/mock/gomock"
+)
+
+func record(status int, headers map[string][]string, body string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	for name, values := range headers {
+		for _, value := range values {
+			w.Header().Add(name, value)
+		}
+	}
+	w.WriteHeader(status)
+	_, _ = io.WriteString(w, body)
+	return w
+}
+
+func Test_HTTP_expectations_should_pass(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+	ok := record(200, map[string][]string{
+		"Content-Type":  {"application/json; charset=utf-8"},
+		"Cache-Control": {"no-cache, max-age=60"},
+		"Vary":          {"Accept", "Origin"},
+	}, `{}`)
+	redirect := record(302, map[string][]string{"Location": {"/login"}}, "")
+
+	// When
+	assert.That(ok).IsSuccess()
+	assert.That(ok).Not().IsRedirect()
+	assert.That(record(404, nil, "")).IsClientError()
+	assert.That(record(503, nil, "")).IsServerError()
+	assert.That(record(503, nil, "")).Not().IsClientError()
+	assert.That(redirect).IsRedirect()
+	assert.That(ok).HasStatus(200)
+	assert.That(ok).Not().HasStatus(201)
+	assert.That(ok).HasContentType("application/json")
+	assert.That(ok).HasContentType("Application/JSON; charset=latin1")
+	assert.That(ok).Not().HasContentType("text/plain")
+	assert.That(redirect).RedirectsTo("/login")
+	assert.That(redirect).Not().RedirectsTo("/home")
+	assert.That(ok).Not().RedirectsTo("/login")
+	assert.That(ok).HasHeaderValues("Vary", "Accept", "Origin")
+	assert.That(ok).Not().HasHeaderValues("Vary", "Accept")
+	assert.That(ok).HasHeaderValues("X-Missing")
+	assert.That(ok).HasCacheControl("no-cache")
+	assert.That(ok).HasCacheControl("max-age")
+	assert.That(ok).HasCacheControl("max-age=60")
+	assert.That(ok).Not().HasCacheControl("max-age=30")
+	assert.That(ok).Not().HasCacheControl("no-store")
+
+	// Then nothing
+}
+
+func Test_HTTP_expectations_should_pass_on_responses(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/old" {
+			http.Redirect(w, r, "/new", http.StatusMovedPermanently)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = io.WriteString(w, "new")
+	}))
+	t.Cleanup(server.Close)
+	client := server.Client()
+	client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+
+	// When
+	res, err := client.Get(server.URL + "/old") //nolint: noctx
+	assert.That(err).IsNil()
+	t.Cleanup(func() { _ = res.Body.Close() })
+
+	// Then
+	assert.That(res).HasStatus(301)
+	assert.That(res).RedirectsTo("/new")
+	assert.That(res).RedirectsTo(server.URL + "/new")
+	assert.That(res).HasContentType("text/html")
+	assert.That(res).BodyToString().MatchRe("Moved Permanently")
+}
+
+func Test_HTTP_expectations_should_fail(t *testing.T) {
+	// Mock preparation
+	ctrl := gomock.NewController(t)
+	notFound := record(404, map[string][]string{"Content-Type": {"text/plain"}}, "page not found")
+	long := record(500, nil, strings.Repeat("a", 300))
+
+	testEntries := []struct {
+		assertFunc func(assert assertion.Assert)
+		errLog     string
+	}{
+		{
+			assertFunc: func(assert assertion.Assert) { assert.That(notFound).IsSuccess() },
+			errLog: "\nResponse status should be a success (2xx). Got : 404 Not Found" +
+				"\nResponse :\nHTTP/1.1 404 Not Found\nContent-Type: text/plain\n\npage not found",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) { assert.That(notFound).Not().IsClientError() },
+			errLog: "\nResponse status should not be a client error (4xx). Got : 404 Not Found" +
+				"\nResponse :\nHTTP/1.1 404 Not Found\nContent-Type: text/plain\n\npage not found",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) { assert.That(long).HasStatus(200) },
+			errLog: "\nResponse status should be : 200 OK\nGot : 500 Internal Server Error" +
+				"\nResponse :\nHTTP/1.1 500 Internal Server Error\n\n" +
+				strings.Repeat("a", 256) + "... (44 more bytes)",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) { assert.That(notFound).HasContentType("application/json") },
+			errLog: "\nResponse content type should be : application/json\nGot : text/plain" +
+				"\nResponse :\nHTTP/1.1 404 Not Found\nContent-Type: text/plain\n\npage not found",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) { assert.That(notFound).RedirectsTo("/home") },
+			errLog: "\nResponse should redirect to : /home\nGot status : 404 Not Found" +
+				"\nResponse :\nHTTP/1.1 404 Not Found\nContent-Type: text/plain\n\npage not found",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) {
+				assert.That(record(307, map[string][]string{"Location": {"/a"}}, "")).RedirectsTo("/b")
+			},
+			errLog: "\nResponse should redirect to : /b\nGot : /a\nResponse :\nHTTP/1.1 307 Temporary Redirect\nLocation: /a",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) {
+				vary := record(200, map[string][]string{"Vary": {"Accept", "Origin"}}, "")
+				assert.That(vary).HasHeaderValues("Vary", "Accept")
+			},
+			errLog: "\nResponse header Vary should have values : [\"Accept\"]\nGot : [\"Accept\" \"Origin\"]" +
+				"\nResponse :\nHTTP/1.1 200 OK\nVary: Accept\nVary: Origin",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) {
+				cached := record(200, map[string][]string{"Cache-Control": {"max-age=30"}}, "")
+				assert.That(cached).HasCacheControl("max-age=60")
+			},
+			errLog: "\nResponse Cache-Control should have directive : max-age=60\nGot : max-age=30" +
+				"\nResponse :\nHTTP/1.1 200 OK\nCache-Control: max-age=30",
+		},
+	}
+
+	for _, entry := range testEntries {
+		// Given
+		tMock := mocks.NewMockPublicTB(ctrl)
+		assert := assertion.New(tMock)
+
+		// Expectation
+		tMock.EXPECT().Helper().AnyTimes()
+		tMock.EXPECT().Error(entry.errLog)
+
+		// When
+		entry.assertFunc(assert)
+	}
+}
+
+func Test_HTTP_expectations_should_error_if_not_response(t *testing.T) {
+	// Mock preparation
+	ctrl := gomock.NewController(t)
+	tMock := mocks.NewMockPublicTB(ctrl)
+	assert := assertion.New(tMock)
+
+	// Expectation
+	tMock.EXPECT().Helper().AnyTimes()
+	tMock.EXPECT().Fatalf("\n%s", assertion.ErrNotOfResponseRecorderType.Error())
+
+	// When
+	assert.That("abc").IsSuccess()
+}
@@ -0,0 +1,198 @@
+package assertion
+
+import (
+	"fmt"
+	"mime"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+const dumpedBodyMaxLen = 256
+
+// toResponse returns the response held by a *httptest.ResponseRecorder or a *http.Response with its body.
+// The *http.Response body is buffered and restored so it can still be read.
+func toResponse(v interface{}) (*http.Response, []byte, error) {
+	switch r := v.(type) {
+	case *httptest.ResponseRecorder:
+		var body []byte
+		if r.Body != nil {
+			body = r.Body.Bytes()
+		}
+		return r.Result(), body, nil //nolint: bodyclose
+	case *http.Response:
+		body, err := bufferBody(stdBytesReader{}, &r.Body)
+		return r, body, err
+	default:
+		return nil, nil, ErrNotOfResponseRecorderType
+	}
+}
+
+func statusLine(code int) string {
+	return strings.TrimSpace(fmt.Sprintf("%d %s", code, http.StatusText(code)))
+}
+
+// dumpResponse formats the response status line, headers and (truncated) body for failure messages.
+func dumpResponse(res *http.Response, body []byte) string {
+	sb := strings.Builder{}
+	proto := res.Proto
+	if proto == "" {
+		proto = "HTTP/1.1"
+	}
+	sb.WriteString(fmt.Sprintf("\nResponse :\n%s %s", proto, statusLine(res.StatusCode)))
+	names := make([]string, 0, len(res.Header))
+	for name := range res.Header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for _, value := range res.Header[name] {
+			sb.WriteString(fmt.Sprintf("\n%s: %s", name, value))
+		}
+	}
+	if len(body) > 0 {
+		sb.WriteString("\n\n")
+		if len(body) > dumpedBodyMaxLen {
+			sb.Write(body[:dumpedBodyMaxLen])
+			sb.WriteString(fmt.Sprintf("... (%d more bytes)", len(body)-dumpedBodyMaxLen))
+		} else {
+			sb.Write(body)
+		}
+	}
+	return sb.String()
+}
+
+// responseMatcher builds a Matcher on the response, messages being followed by the response dump.
+func responseMatcher(check func(res *http.Response) (matches bool, log string, nlog string)) Matcher {
+	return func(v interface{}) (MatchResult, error) {
+		res, body, err := toResponse(v)
+		if err != nil {
+			return errored(err)
+		}
+		matches, log, nlog := check(res)
+		if matches {
+			return truthy(nlog + dumpResponse(res, body))
+		}
+		return falsy(log + dumpResponse(res, body))
+	}
+}
+
+func statusClass(class int, name string) Matcher {
+	return responseMatcher(func(res *http.Response) (bool, string, string) {
+		got := statusLine(res.StatusCode)
+		return res.StatusCode/100 == class,
+			fmt.Sprintf("\nResponse status should be %s (%dxx). Got : %s", name, class, got),
+			fmt.Sprintf("\nResponse status should not be %s (%dxx). Got : %s", name, class, got)
+	})
+}
+
+func IsSuccess() Matcher {
+	return statusClass(2, "a success")
+}
+
+func IsRedirect() Matcher {
+	return statusClass(3, "a redirection")
+}
+
+func IsClientError() Matcher {
+	return statusClass(4, "a client error")
+}
+
+func IsServerError() Matcher {
+	return statusClass(5, "a server error")
+}
+
+func HasStatus(code int) Matcher {
+	return responseMatcher(func(res *http.Response) (bool, string, string) {
+		return res.StatusCode == code,
+			fmt.Sprintf("\nResponse status should be : %s\nGot : %s", statusLine(code), statusLine(res.StatusCode)),
+			fmt.Sprintf("\nResponse status should not be : %s", statusLine(code))
+	})
+}
+
+func HasContentType(mimeType string) Matcher {
+	return responseMatcher(func(res *http.Response) (bool, string, string) {
+		expected, _, err := mime.ParseMediaType(mimeType)
+		if err != nil {
+			expected = mimeType
+		}
+		contentType := res.Header.Get("Content-Type")
+		got, _, err := mime.ParseMediaType(contentType)
+		return err == nil && strings.EqualFold(expected, got),
+			fmt.Sprintf("\nResponse content type should be : %s\nGot : %s", expected, contentType),
+			fmt.Sprintf("\nResponse content type should not be : %s", expected)
+	})
+}
+
+func RedirectsTo(location string) Matcher {
+	return responseMatcher(func(res *http.Response) (bool, string, string) {
+		got := res.Header.Get("Location")
+		if res.StatusCode/100 != 3 {
+			return false,
+				fmt.Sprintf("\nResponse should redirect to : %s\nGot status : %s", location, statusLine(res.StatusCode)),
+				""
+		}
+		return sameLocation(res, location, got),
+			fmt.Sprintf("\nResponse should redirect to : %s\nGot : %s", location, got),
+			fmt.Sprintf("\nResponse should not redirect to : %s", location)
+	})
+}
+
+// sameLocation compares locations as is, or resolved against the response request URL when available.
+func sameLocation(res *http.Response, expected, got string) bool {
+	if expected == got {
+		return true
+	}
+	if res.Request == nil || res.Request.URL == nil {
+		return false
+	}
+	e, errE := url.Parse(expected)
+	g, errG := url.Parse(got)
+	if errE != nil || errG != nil {
+		return false
+	}
+	return res.Request.URL.ResolveReference(e).String() == res.Request.URL.ResolveReference(g).String()
+}
+
+func HasHeaderValues(name string, values ...string) Matcher {
+	return responseMatcher(func(res *http.Response) (bool, string, string) {
+		got := res.Header.Values(name)
+		matches := len(got) == len(values) && (len(got) == 0 || reflect.DeepEqual(got, values))
+		return matches,
+			fmt.Sprintf("\nResponse header %s should have values : %q\nGot : %q", name, values, got),
+			fmt.Sprintf("\nResponse header %s should not have values : %q", name, values)
+	})
+}
+
+// HasCacheControl matches a Cache-Control directive. A directive without value (eg. "max-age")
+// matches whatever its value, otherwise (eg. "max-age=60") the value should be the same.
+func HasCacheControl(directive string) Matcher {
+	return responseMatcher(func(res *http.Response) (bool, string, string) {
+		cacheControl := strings.Join(res.Header.Values("Cache-Control"), ", ")
+		return hasDirective(cacheControl, directive),
+			fmt.Sprintf("\nResponse Cache-Control should have directive : %s\nGot : %s", directive, cacheControl),
+			fmt.Sprintf("\nResponse Cache-Control should not have directive : %s", directive)
+	})
+}
+
+func hasDirective(cacheControl, directive string) bool {
+	expectedName, expectedValue, withValue := splitDirective(directive)
+	for _, d := range strings.Split(cacheControl, ",") {
+		name, value, _ := splitDirective(d)
+		if strings.EqualFold(name, expectedName) && (!withValue || value == expectedValue) {
+			return true
+		}
+	}
+	return false
+}
+
+func splitDirective(directive string) (name string, value string, withValue bool) {
+	parts := strings.SplitN(strings.TrimSpace(directive), "=", 2)
+	if len(parts) == 1 {
+		return parts[0], "", false
+	}
+	return strings.TrimSpace(parts[0]), strings.Trim(strings.TrimSpace(parts[1]), `"`), true
+}
@@ -3,15 +3,18 @@ package assertion
 import (
 	"bytes"
 	"encoding/json"
+	"io"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 )
 
-// HTTPRecorderParser interface encloses *httptest.ResponseRecorder value transformations.
+// HTTPRecorderParser interface encloses *httptest.ResponseRecorder and *http.Response value transformations.
 // All transformations return the same expectation interface to pile in calls (Fluent API).
 //
 // Note: all body transformations do not drain the response buffer. Which means that multiple
-// transformations on the same *httptest.ResponseRecorder value can be executed.
+// transformations on the same *httptest.ResponseRecorder or *http.Response value can be executed
+// (the *http.Response body is buffered and restored).
 //
 // DecodeBody(decoder func(*bytes.Buffer) (interface{}, error))
 // Changes value to the body using a custom Buffer decoder.
@@ -23,14 +26,15 @@ import (
 // JSONBodyToSlice() Changes value to the body as slice from JSON decoding.
 //
 // Response() Changes value to the response value. (*httptest.ResponseRecorder response attribute)
+// A *http.Response value is kept as is.
 //
 // Status() Changes value to the response status code.
 //
-// Headers() Changes value to the response Headers map.
+// Headers() Changes value to the response (or *http.Request) Headers map.
 //
 // Header(header string) Changes value to a specific Header.
 //
-// Cookies() Changes value to the response Cookies slice.
+// Cookies() Changes value to the response (or *http.Request) Cookies slice.
 //
 // Cookie(cookie string) Changes value to a specific Cookie.
 type HTTPRecorderParser interface {
@@ -70,30 +74,63 @@ func (exp *expectation) JSONBodyToSlice() Expectation {
 }
 
 func (exp *expectation) DecodeBody(decoder func(*bytes.Buffer) (interface{}, error)) Expectation {
-	recorder, ok := exp.v.(*httptest.ResponseRecorder)
-	if !ok {
+	var originalBody []byte
+	switch r := exp.v.(type) {
+	case *httptest.ResponseRecorder:
+		// Body content is read and preserved
+		var err error
+		originalBody, err = exp.assert.br.ReadAll(r.Body)
+		if err != nil {
+			panic(err)
+		}
+		r.Body = bytes.NewBuffer(originalBody)
+	case *http.Response:
+		originalBody = exp.bufferBody(&r.Body)
+	default:
 		panic(ErrNotOfResponseRecorderType)
 	}
-	// Body content is read and preserved
-	originalBody, err := exp.assert.br.ReadAll(recorder.Body)
-	if err != nil {
-		panic(err)
-	}
 	body, err := decoder(bytes.NewBuffer(originalBody))
 	if err != nil {
 		panic(err)
 	}
-	recorder.Body = bytes.NewBuffer(originalBody)
 	exp.v = body
 	return exp
 }
 
+// bufferBody reads the whole body and replaces it by an in memory copy, so it can be read again.
+func (exp *expectation) bufferBody(body *io.ReadCloser) []byte {
+	content, err := bufferBody(exp.assert.br, body)
+	if err != nil {
+		panic(err)
+	}
+	return content
+}
+
+func bufferBody(br bytesReader, body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return []byte{}, nil
+	}
+	content, err := br.ReadAll(*body)
+	if err != nil {
+		return nil, err
+	}
+	_ = (*body).Close()
+	*body = bytesReadCloser(content)
+	return content, nil
+}
+
+func bytesReadCloser(content []byte) io.ReadCloser {
+	return ioutil.NopCloser(bytes.NewReader(content))
+}
+
 func (exp *expectation) Response() Expectation {
-	recorder, ok := exp.v.(*httptest.ResponseRecorder)
-	if !ok {
+	switch r := exp.v.(type) {
+	case *httptest.ResponseRecorder:
+		exp.v = r.Result() //nolint: bodyclose
+	case *http.Response:
+	default:
 		panic(ErrNotOfResponseRecorderType)
 	}
-	exp.v = recorder.Result() //nolint: bodyclose
 	return exp
 }
 
@@ -108,6 +145,10 @@ func (exp *expectation) Status() Expectation {
 }
 
 func (exp *expectation) Headers() Expectation {
+	if req, ok := exp.v.(*http.Request); ok {
+		exp.v = req.Header
+		return exp
+	}
 	exp.Response()
 	response, ok := exp.v.(*http.Response)
 	if !ok {
@@ -118,6 +159,10 @@ func (exp *expectation) Headers() Expectation {
 }
 
 func (exp *expectation) Header(header string) Expectation {
+	if req, ok := exp.v.(*http.Request); ok {
+		exp.v = req.Header.Get(header)
+		return exp
+	}
 	exp.Response()
 	response, ok := exp.v.(*http.Response)
 	if !ok {
@@ -128,6 +173,10 @@ func (exp *expectation) Header(header string) Expectation {
 }
 
 func (exp *expectation) Cookies() Expectation {
+	if req, ok := exp.v.(*http.Request); ok {
+		exp.v = req.Cookies()
+		return exp
+	}
 	exp.Response()
 	response, ok := exp.v.(*http.Response)
 	if !ok {
@@ -138,12 +187,11 @@ func (exp *expectation) Cookies() Expectation {
 }
 
 func (exp *expectation) Cookie(cookie string) Expectation {
-	exp.Response()
-	response, ok := exp.v.(*http.Response)
+	exp.Cookies()
+	cookies, ok := exp.v.([]*http.Cookie)
 	if !ok {
-		panic("value should be of type *http.Response")
+		panic("value should be of type []*http.Cookie")
 	}
-	cookies := response.Cookies()
 	for _, c := range cookies {
 		if c.Name == cookie {
 			exp.v = c
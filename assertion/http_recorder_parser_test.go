@@ -154,3 +154,35 @@ func Test_Multiple_Http_record_transformation_passes(t *testing.T) {
 	assert.That(w).Cookie("my-cookie").Attr("Value").IsEq("654321")
 	assert.That(w).Cookie("my-cookie-2").IsNil()
 }
+
+func Test_Multiple_Http_response_transformation_passes(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.SetCookie(w, &http.Cookie{Name: "my-cookie", Value: "654321"})
+		w.Header().Add("X-SOME-HEADER", "123456")
+		w.WriteHeader(201)
+
+		_, _ = io.WriteString(w, `{"a": ["b", "c"]}`)
+	}))
+	t.Cleanup(server.Close)
+
+	// When
+	res, err := http.Get(server.URL) //nolint: noctx
+	assert.That(err).IsNil()
+	t.Cleanup(func() { _ = res.Body.Close() })
+
+	// Then
+	assert.That(res).Status().IsEq(201)
+	assert.That(res).Response().IsEq(res)
+	assert.That(res).Header("X-SOME-HEADER").IsEq("123456")
+	assert.That(res).Headers().Attr("X-Some-Header").IsDeepEq([]string{"123456"})
+	assert.That(res).Cookies().HasLen(1)
+	assert.That(res).Cookie("my-cookie").Attr("Value").IsEq("654321")
+	assert.That(res).BodyToString().IsEq(`{"a": ["b", "c"]}`)
+	assert.That(res).BodyToString().IsEq(`{"a": ["b", "c"]}`)
+	assert.That(res).JSONBodyToMap().IsDeepEq(map[string]interface{}{"a": []interface{}{"b", "c"}})
+	assert.That(res).JSONBodyToMap().Attr("a").Index(1).IsEq("c")
+	assert.That(&http.Response{}).BodyToString().IsEq("")
+}
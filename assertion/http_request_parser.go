@@ -0,0 +1,113 @@
+package assertion
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+)
+
+// HTTPRequestParser interface encloses *http.Request value transformations (eg. requests captured by fake servers).
+// All transformations return the same expectation interface to pile in calls (Fluent API).
+//
+// Note: body transformations (including Form) buffer and restore the request body. Which means that multiple
+// transformations on the same *http.Request value can be executed.
+//
+// Method() Changes value to the request method.
+//
+// URL() Changes value to the request *url.URL.
+//
+// Query(name string) Changes value to the first value of the named URL query parameter.
+//
+// Form(name string) Changes value to the first value of the named form field (URL query or url encoded body).
+//
+// DecodeRequestBody(decoder func(*bytes.Buffer) (interface{}, error))
+// Changes value to the request body using a custom Buffer decoder.
+//
+// RequestBodyToString() Changes value to the request body as String.
+//
+// RequestJSONBodyToMap() Changes value to the request body as map from JSON decoding.
+//
+// RequestJSONBodyToSlice() Changes value to the request body as slice from JSON decoding.
+//
+// Headers(), Header(header string), Cookies() and Cookie(cookie string) also apply to *http.Request values.
+type HTTPRequestParser interface {
+	Method() Expectation
+	URL() Expectation
+	Query(name string) Expectation
+	Form(name string) Expectation
+	DecodeRequestBody(decoder func(*bytes.Buffer) (interface{}, error)) Expectation
+	RequestBodyToString() Expectation
+	RequestJSONBodyToMap() Expectation
+	RequestJSONBodyToSlice() Expectation
+}
+
+func requestOrPanic(v interface{}) *http.Request {
+	req, ok := v.(*http.Request)
+	if !ok {
+		panic(ErrNotOfRequestType)
+	}
+	return req
+}
+
+func (exp *expectation) Method() Expectation {
+	exp.v = requestOrPanic(exp.v).Method
+	return exp
+}
+
+func (exp *expectation) URL() Expectation {
+	exp.v = requestOrPanic(exp.v).URL
+	return exp
+}
+
+func (exp *expectation) Query(name string) Expectation {
+	exp.v = requestOrPanic(exp.v).URL.Query().Get(name)
+	return exp
+}
+
+func (exp *expectation) Form(name string) Expectation {
+	req := requestOrPanic(exp.v)
+	// Form is parsed from a copy so the request body stays readable
+	body := exp.bufferBody(&req.Body)
+	parsed := req.Clone(req.Context())
+	parsed.Body = http.NoBody
+	if len(body) > 0 {
+		parsed.Body = bytesReadCloser(body)
+	}
+	if err := parsed.ParseForm(); err != nil {
+		panic(err)
+	}
+	exp.v = parsed.Form.Get(name)
+	return exp
+}
+
+func (exp *expectation) DecodeRequestBody(decoder func(*bytes.Buffer) (interface{}, error)) Expectation {
+	req := requestOrPanic(exp.v)
+	body, err := decoder(bytes.NewBuffer(exp.bufferBody(&req.Body)))
+	if err != nil {
+		panic(err)
+	}
+	exp.v = body
+	return exp
+}
+
+func (exp *expectation) RequestBodyToString() Expectation {
+	return exp.DecodeRequestBody(func(body *bytes.Buffer) (interface{}, error) {
+		return body.String(), nil
+	})
+}
+
+func (exp *expectation) RequestJSONBodyToMap() Expectation {
+	return exp.DecodeRequestBody(func(body *bytes.Buffer) (interface{}, error) {
+		parsed := make(map[string]interface{})
+		err := json.NewDecoder(body).Decode(&parsed)
+		return parsed, err
+	})
+}
+
+func (exp *expectation) RequestJSONBodyToSlice() Expectation {
+	return exp.DecodeRequestBody(func(body *bytes.Buffer) (interface{}, error) {
+		parsed := make([]interface{}, 0)
+		err := json.NewDecoder(body).Decode(&parsed)
+		return parsed, err
+	})
+}
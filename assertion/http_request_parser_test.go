@@ -0,0 +1,78 @@
+package assertion_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/elethoughts-code/goasserts/assertion"
+)
+
+func Test_Http_request_transformations_passes(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+	req := httptest.NewRequest("POST", "/users?page=2&sort=name", strings.NewReader("name=bob&age=32"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.AddCookie(&http.Cookie{Name: "session", Value: "abc"})
+
+	// When / Then
+	assert.That(req).Method().IsEq("POST")
+	assert.That(req).URL().Attr("Path").IsEq("/users")
+	assert.That(req).Query("page").IsEq("2")
+	assert.That(req).Query("missing").IsEq("")
+	assert.That(req).Form("name").IsEq("bob")
+	assert.That(req).Form("sort").IsEq("name")
+	assert.That(req).RequestBodyToString().IsEq("name=bob&age=32")
+	assert.That(req).Form("age").IsEq("32")
+	assert.That(req).Header("Content-Type").IsEq("application/x-www-form-urlencoded")
+	assert.That(req).Headers().HasLen(2)
+	assert.That(req).Cookies().HasLen(1)
+	assert.That(req).Cookie("session").Attr("Value").IsEq("abc")
+}
+
+func Test_Http_request_JSON_body_transformations_passes(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+	var captured *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		captured = r
+		assert.That(r).RequestJSONBodyToMap().IsDeepEq(map[string]interface{}{"name": "bob"})
+		assert.That(r).RequestBodyToString().IsEq(`{"name": "bob"}`)
+		w.WriteHeader(204)
+	}))
+	t.Cleanup(server.Close)
+
+	// When
+	res, err := http.Post(server.URL, "application/json", strings.NewReader(`{"name": "bob"}`)) //nolint: noctx
+	assert.That(err).IsNil()
+	_ = res.Body.Close()
+
+	// Then
+	assert.That(captured).Method().IsEq("POST")
+	assert.That(httptest.NewRequest("PUT", "/", strings.NewReader(`[1, 2]`))).
+		RequestJSONBodyToSlice().IsDeepEq([]interface{}{1.0, 2.0})
+	assert.That(httptest.NewRequest("GET", "/", nil)).DecodeRequestBody(func(body *bytes.Buffer) (interface{}, error) {
+		return body.Len(), nil
+	}).IsEq(0)
+}
+
+func Test_Request_transformations_should_panic_when_no_request_passed(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+	panicFunc := func(expect func(e assertion.Expectation)) {
+		defer func() {
+			r := recover()
+			assert.That(r).IsEq(assertion.ErrNotOfRequestType)
+		}()
+		expect(assert.That(123))
+	}
+
+	// When / Then
+	panicFunc(func(e assertion.Expectation) { e.Method() })
+	panicFunc(func(e assertion.Expectation) { e.URL() })
+	panicFunc(func(e assertion.Expectation) { e.Query("a") })
+	panicFunc(func(e assertion.Expectation) { e.Form("a") })
+	panicFunc(func(e assertion.Expectation) { e.RequestBodyToString() })
+}
@@ -0,0 +1,64 @@
+package assertion
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+)
+
+// JSONDecodeOption configures the JSON decoder used by JSON body transformations.
+type JSONDecodeOption func(d *json.Decoder)
+
+// DisallowUnknownFields option makes decoding fail when an object has a key not matching a target field.
+func DisallowUnknownFields() JSONDecodeOption {
+	return func(d *json.Decoder) {
+		d.DisallowUnknownFields()
+	}
+}
+
+// UseNumber option decodes numbers as json.Number instead of float64 (avoiding precision loss on big IDs).
+func UseNumber() JSONDecodeOption {
+	return func(d *json.Decoder) {
+		d.UseNumber()
+	}
+}
+
+// JSONBodyParser interface encloses *httptest.ResponseRecorder and *http.Response typed JSON body transformations.
+// All transformations return the same expectation interface to pile in calls (Fluent API).
+//
+// JSONBodyTo(target interface{}, opts ...JSONDecodeOption) decodes the body into target (a pointer)
+// and changes value to the decoded target (pointed value).
+//
+// JSONBody(opts ...JSONDecodeOption) changes value to the decoded body whatever its JSON type
+// (object, array, string, number, boolean or null).
+type JSONBodyParser interface {
+	JSONBodyTo(target interface{}, opts ...JSONDecodeOption) Expectation
+	JSONBody(opts ...JSONDecodeOption) Expectation
+}
+
+func decodeJSON(body *bytes.Buffer, target interface{}, opts []JSONDecodeOption) error {
+	decoder := json.NewDecoder(body)
+	for _, opt := range opts {
+		opt(decoder)
+	}
+	return decoder.Decode(target)
+}
+
+func (exp *expectation) JSONBodyTo(target interface{}, opts ...JSONDecodeOption) Expectation {
+	t := reflect.ValueOf(target)
+	if t.Kind() != reflect.Ptr || t.IsNil() {
+		panic("[type error] target should be a non nil pointer")
+	}
+	return exp.DecodeBody(func(body *bytes.Buffer) (interface{}, error) {
+		err := decodeJSON(body, target, opts)
+		return t.Elem().Interface(), err
+	})
+}
+
+func (exp *expectation) JSONBody(opts ...JSONDecodeOption) Expectation {
+	return exp.DecodeBody(func(body *bytes.Buffer) (interface{}, error) {
+		var parsed interface{}
+		err := decodeJSON(body, &parsed, opts)
+		return parsed, err
+	})
+}
@@ -0,0 +1,75 @@
+package assertion_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/elethoughts-code/goasserts/assertion"
+)
+
+type account struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+func jsonRecord(body string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = io.WriteString(w, body)
+	return w
+}
+
+func Test_JSONBodyTo_should_pass_assertions(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+	w := jsonRecord(`{"id": 9007199254740993, "name": "bob", "extra": true}`)
+
+	// When / Then
+	assert.That(w).JSONBodyTo(&account{}).IsDeepEq(account{ID: 9007199254740993, Name: "bob"})
+	assert.That(w).JSONBodyTo(&account{}).Attr("Name").IsEq("bob")
+	assert.That(w).JSONBodyTo(&map[string]interface{}{}).HasLen(3)
+	assert.That(jsonRecord(`"text"`)).JSONBodyTo(new(string)).IsEq("text")
+}
+
+func Test_JSONBodyTo_should_panic_on_unknown_fields(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+
+	// When / Then
+	defer func() {
+		r := recover()
+		assert.That(r).HasErrorMessage(`json: unknown field "extra"`)
+	}()
+	assert.That(jsonRecord(`{"id": 1, "extra": true}`)).JSONBodyTo(&account{}, assertion.DisallowUnknownFields())
+}
+
+func Test_JSONBodyTo_should_panic_if_target_not_pointer(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+
+	// When / Then
+	defer func() {
+		r := recover()
+		assert.That(r).IsEq("[type error] target should be a non nil pointer")
+	}()
+	assert.That(jsonRecord(`{}`)).JSONBodyTo(account{})
+}
+
+func Test_JSONBody_should_pass_assertions(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+
+	// When / Then
+	assert.That(jsonRecord(`12.5`)).JSONBody().IsEq(12.5)
+	assert.That(jsonRecord(`"text"`)).JSONBody().IsEq("text")
+	assert.That(jsonRecord(`null`)).JSONBody().IsNil()
+	assert.That(jsonRecord(`true`)).JSONBody().IsEq(true)
+	assert.That(jsonRecord(`[1]`)).JSONBody().IsDeepEq([]interface{}{1.0})
+	assert.That(jsonRecord(`{"id": 9007199254740993}`)).JSONBody(assertion.UseNumber()).
+		Attr("id").IsEq(json.Number("9007199254740993"))
+	assert.That(&http.Response{Body: io.NopCloser(jsonRecord(`{"a": 1}`).Body)}).JSONBody().
+		IsDeepEq(map[string]interface{}{"a": 1.0})
+}
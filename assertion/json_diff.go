@@ -0,0 +1,164 @@
+package assertion
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type jsonEqConfig struct {
+	unordered bool
+	epsilon   float64
+}
+
+// JSONEqOption configures JSON documents comparison.
+type JSONEqOption func(c *jsonEqConfig)
+
+// IgnoreArrayOrder option compares JSON arrays regardless of their items order.
+func IgnoreArrayOrder() JSONEqOption {
+	return func(c *jsonEqConfig) {
+		c.unordered = true
+	}
+}
+
+// NumberPrecision option considers numbers equal when their difference is at most epsilon.
+// By default numbers are compared exactly (without float64 precision loss).
+func NumberPrecision(epsilon float64) JSONEqOption {
+	return func(c *jsonEqConfig) {
+		c.epsilon = epsilon
+	}
+}
+
+type jsonDiff struct {
+	pointer string
+	log     string
+}
+
+func jsonPointer(parent string, token string) string {
+	return parent + "/" + strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+func (c *jsonEqConfig) numbersEqual(a, b json.Number) bool {
+	if c.epsilon > 0 {
+		fa, errA := a.Float64()
+		fb, errB := b.Float64()
+		return errA == nil && errB == nil && math.Abs(fa-fb) <= c.epsilon
+	}
+	ra, okA := new(big.Rat).SetString(a.String())
+	rb, okB := new(big.Rat).SetString(b.String())
+	if !okA || !okB {
+		return a == b
+	}
+	return ra.Cmp(rb) == 0
+}
+
+// diffs returns the differences of the got JSON value compared to the expected one.
+func (c *jsonEqConfig) diffs(pointer string, expected, got interface{}) []jsonDiff {
+	switch e := expected.(type) {
+	case map[string]interface{}:
+		if g, ok := got.(map[string]interface{}); ok {
+			return c.objectDiffs(pointer, e, g)
+		}
+	case []interface{}:
+		if g, ok := got.([]interface{}); ok {
+			if c.unordered {
+				return c.unorderedDiffs(pointer, e, g)
+			}
+			return c.arrayDiffs(pointer, e, g)
+		}
+	case json.Number:
+		if g, ok := got.(json.Number); ok && c.numbersEqual(e, g) {
+			return nil
+		}
+	default:
+		if expected == got {
+			return nil
+		}
+	}
+	return []jsonDiff{{pointer: pointer, log: fmt.Sprintf("expected %s, got %s", jsonString(expected), jsonString(got))}}
+}
+
+func (c *jsonEqConfig) objectDiffs(pointer string, expected, got map[string]interface{}) []jsonDiff {
+	keys := make([]string, 0, len(expected)+len(got))
+	for k := range expected {
+		keys = append(keys, k)
+	}
+	for k := range got {
+		if _, ok := expected[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	result := make([]jsonDiff, 0)
+	for _, k := range keys {
+		e, inExpected := expected[k]
+		g, inGot := got[k]
+		p := jsonPointer(pointer, k)
+		switch {
+		case !inGot:
+			result = append(result, jsonDiff{pointer: p, log: fmt.Sprintf("missing, expected %s", jsonString(e))})
+		case !inExpected:
+			result = append(result, jsonDiff{pointer: p, log: fmt.Sprintf("unexpected %s", jsonString(g))})
+		default:
+			result = append(result, c.diffs(p, e, g)...)
+		}
+	}
+	return result
+}
+
+func (c *jsonEqConfig) arrayDiffs(pointer string, expected, got []interface{}) []jsonDiff {
+	result := make([]jsonDiff, 0)
+	for i := 0; i < len(expected) || i < len(got); i++ {
+		p := jsonPointer(pointer, strconv.Itoa(i))
+		switch {
+		case i >= len(got):
+			result = append(result, jsonDiff{pointer: p, log: fmt.Sprintf("missing, expected %s", jsonString(expected[i]))})
+		case i >= len(expected):
+			result = append(result, jsonDiff{pointer: p, log: fmt.Sprintf("unexpected %s", jsonString(got[i]))})
+		default:
+			result = append(result, c.diffs(p, expected[i], got[i])...)
+		}
+	}
+	return result
+}
+
+// unorderedDiffs pairs equal items first (maximum matching, see unorderedMatching),
+// unpaired items are then compared in order of appearance.
+func (c *jsonEqConfig) unorderedDiffs(pointer string, expected, got []interface{}) []jsonDiff {
+	gotMatch, expectedMatch, _ := unorderedMatching(got, expected, func(g, e interface{}) bool {
+		return len(c.diffs("", e, g)) == 0
+	})
+	remainingExpected := make([]int, 0)
+	for i := range expected {
+		if expectedMatch[i] < 0 {
+			remainingExpected = append(remainingExpected, i)
+		}
+	}
+	remainingGot := make([]int, 0)
+	for j := range got {
+		if gotMatch[j] < 0 {
+			remainingGot = append(remainingGot, j)
+		}
+	}
+	result := make([]jsonDiff, 0)
+	for k := 0; k < len(remainingExpected) || k < len(remainingGot); k++ {
+		switch {
+		case k >= len(remainingGot):
+			i := remainingExpected[k]
+			result = append(result, jsonDiff{pointer: jsonPointer(pointer, strconv.Itoa(i)),
+				log: fmt.Sprintf("missing, expected %s", jsonString(expected[i]))})
+		case k >= len(remainingExpected):
+			j := remainingGot[k]
+			result = append(result, jsonDiff{pointer: jsonPointer(pointer, strconv.Itoa(j)),
+				log: fmt.Sprintf("unexpected %s", jsonString(got[j]))})
+		default:
+			i, j := remainingExpected[k], remainingGot[k]
+			result = append(result, c.diffs(jsonPointer(pointer, strconv.Itoa(j)), expected[i], got[j])...)
+		}
+	}
+	return result
+}
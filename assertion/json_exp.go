@@ -0,0 +1,33 @@
+package assertion
+
+// JSONExpectation interface encloses JSON documents related expectations.
+// JSON documents are taken from *httptest.ResponseRecorder or *http.Response bodies, strings, []byte, io.Reader
+// or any other value marshaled to JSON.
+//
+// MatchesJSONSchema(schema string) check if the document is valid against the JSON schema
+// (a draft 7 subset implemented locally, supporting local $ref only). Each violation is reported with its path.
+//
+// IsJSONEq(expected string, opts ...JSONEqOption) check if the document is semantically equal to expected
+// (keys order and number formats are ignored). Each difference is reported with its JSON Pointer (eg. /items/3/name).
+//
+// BodyIsJSONEq(expected string, opts ...JSONEqOption) is the same as IsJSONEq restricted to responses body.
+type JSONExpectation interface {
+	MatchesJSONSchema(schema string)
+	IsJSONEq(expected string, opts ...JSONEqOption)
+	BodyIsJSONEq(expected string, opts ...JSONEqOption)
+}
+
+func (exp *expectation) MatchesJSONSchema(schema string) {
+	exp.t.Helper()
+	exp.Matches(MatchesJSONSchema(schema))
+}
+
+func (exp *expectation) IsJSONEq(expected string, opts ...JSONEqOption) {
+	exp.t.Helper()
+	exp.Matches(IsJSONEq(expected, opts...))
+}
+
+func (exp *expectation) BodyIsJSONEq(expected string, opts ...JSONEqOption) {
+	exp.t.Helper()
+	exp.Matches(BodyIsJSONEq(expected, opts...))
+}
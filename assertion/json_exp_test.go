@@ -0,0 +1,250 @@
+package assertion_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/elethoughts-code/goasserts/assertion"
+	mocks "github.com/elethoughts-code/goasserts/mocks/assertion"
+	"github.com/golang/mock/gomock"
+)
+
+const accountSchema = `{
+	"type": "object",
+	"required": ["id", "name"],
+	"additionalProperties": false,
+	"properties": {
+		"id": {"type": "integer", "minimum": 1},
+		"name": {"type": "string", "minLength": 2, "pattern": "^[a-z]+$"},
+		"role": {"enum": ["admin", "user"]},
+		"tags": {"type": "array", "items": {"$ref": "#/definitions/tag"}, "uniqueItems": true, "maxItems": 3}
+	},
+	"definitions": {
+		"tag": {"type": "string", "maxLength": 5}
+	}
+}`
+
+func Test_MatchesJSONSchema_should_pass(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+
+	// When
+	assert.That(jsonRecord(`{"id": 9007199254740993, "name": "bob", "tags": ["a", "b"]}`)).
+		MatchesJSONSchema(accountSchema)
+	assert.That(`{"id": 1, "name": "al", "role": "admin"}`).MatchesJSONSchema(accountSchema)
+	assert.That([]byte(`{"id": 0, "name": "al"}`)).Not().MatchesJSONSchema(accountSchema)
+	assert.That(map[string]interface{}{"id": 3, "name": "carol"}).MatchesJSONSchema(accountSchema)
+	assert.That(`12`).MatchesJSONSchema(`{"type": ["integer", "null"], "multipleOf": 3}`)
+	assert.That(`null`).MatchesJSONSchema(`{"type": ["integer", "null"]}`)
+	assert.That(`1.5`).Not().MatchesJSONSchema(`{"type": "integer"}`)
+	assert.That(`1.5`).MatchesJSONSchema(`{"type": "number", "exclusiveMaximum": 2}`)
+	assert.That(`"b"`).MatchesJSONSchema(`{"oneOf": [{"const": "a"}, {"const": "b"}]}`)
+	assert.That(`"c"`).Not().MatchesJSONSchema(`{"anyOf": [{"const": "a"}, {"const": "b"}]}`)
+	assert.That(`"c"`).MatchesJSONSchema(`{"not": {"const": "a"}}`)
+	assert.That(`[1, "a"]`).MatchesJSONSchema(`{"items": [{"type": "integer"}], "additionalItems": {"type": "string"}}`)
+	assert.That(`[1, 2]`).MatchesJSONSchema(`{"contains": {"const": 2}}`)
+	assert.That(`{"x-a": 1}`).MatchesJSONSchema(`{"patternProperties": {"^x-": {"type": "integer"}}, ` +
+		`"additionalProperties": false}`)
+
+	// Then nothing
+}
+
+func Test_MatchesJSONSchema_should_fail(t *testing.T) {
+	// Mock preparation
+	ctrl := gomock.NewController(t)
+
+	testEntries := []struct {
+		assertFunc func(assert assertion.Assert)
+		errLog     string
+	}{
+		{
+			assertFunc: func(assert assertion.Assert) {
+				assert.That(`{"id": 0, "name": "Bob", "role": "guest", "tags": ["a", "a", "toolong"], "age": 3}`).
+					MatchesJSONSchema(accountSchema)
+			},
+			errLog: "\nValue do not match JSON schema :" +
+				"\n  $ : additional property age is not allowed" +
+				"\n  $.id : should be >= 1, got 0" +
+				"\n  $.name : should match pattern ^[a-z]+$, got \"Bob\"" +
+				"\n  $.role : should be one of [\"admin\",\"user\"], got \"guest\"" +
+				"\n  $.tags : items should be unique, [0] and [1] are equal" +
+				"\n  $.tags[2] : should have at most 5 characters, got 7",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) { assert.That(`{"name": 1}`).MatchesJSONSchema(accountSchema) },
+			errLog: "\nValue do not match JSON schema :" +
+				"\n  $ : required property id is missing" +
+				"\n  $.name : should be of type string, got integer",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) {
+				assert.That(`{"id": 1, "name": "al"}`).Not().MatchesJSONSchema(accountSchema)
+			},
+			errLog: "\nValue should not match JSON schema",
+		},
+	}
+
+	for _, entry := range testEntries {
+		// Given
+		tMock := mocks.NewMockPublicTB(ctrl)
+		assert := assertion.New(tMock)
+
+		// Expectation
+		tMock.EXPECT().Helper().AnyTimes()
+		tMock.EXPECT().Error(entry.errLog)
+
+		// When
+		entry.assertFunc(assert)
+	}
+}
+
+func Test_MatchesJSONSchema_should_error_on_invalid_schema(t *testing.T) {
+	// Mock preparation
+	ctrl := gomock.NewController(t)
+	tMock := mocks.NewMockPublicTB(ctrl)
+	assert := assertion.New(tMock)
+
+	// Expectation
+	tMock.EXPECT().Helper().AnyTimes()
+	tMock.EXPECT().Fatalf("\n%s", "invalid JSON schema : $ref #/definitions/missing not found")
+
+	// When
+	assert.That(`1`).MatchesJSONSchema(`{"$ref": "#/definitions/missing"}`)
+}
+
+func Test_MatchesJSONSchema_should_error_on_cyclic_ref(t *testing.T) {
+	// Mock preparation
+	ctrl := gomock.NewController(t)
+
+	testEntries := []struct {
+		schema string
+		errLog string
+	}{
+		{
+			schema: `{"$ref": "#"}`,
+			errLog: "invalid JSON schema : $ref # is cyclic at $",
+		},
+		{
+			schema: `{"$ref": "#/definitions/a", "definitions": {"a": {"$ref": "#/definitions/b"}, ` +
+				`"b": {"anyOf": [{"$ref": "#/definitions/a"}]}}}`,
+			errLog: "invalid JSON schema : $ref #/definitions/a is cyclic at $",
+		},
+	}
+
+	for _, entry := range testEntries {
+		// Given
+		tMock := mocks.NewMockPublicTB(ctrl)
+		assert := assertion.New(tMock)
+
+		// Expectation
+		tMock.EXPECT().Helper().AnyTimes()
+		tMock.EXPECT().Fatalf("\n%s", entry.errLog)
+
+		// When
+		assert.That(`1`).MatchesJSONSchema(entry.schema)
+	}
+}
+
+func Test_MatchesJSONSchema_should_follow_recursive_ref(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+	treeSchema := `{"$ref": "#/definitions/node", "definitions": {"node": {"type": "object", "required": ["id"], ` +
+		`"properties": {"id": {"type": "integer"}, "children": {"type": "array", "items": {"$ref": "#/definitions/node"}},` +
+		` "first": {"anyOf": [{"type": "null"}, {"$ref": "#/definitions/node"}]}}}}}`
+
+	// When
+	assert.That(`{"id": 1, "children": [{"id": 2, "children": [{"id": 3}]}], "first": {"id": 2, "first": null}}`).
+		MatchesJSONSchema(treeSchema)
+	assert.That(`{"id": 1, "children": [{"id": 2, "children": [{"id": "3"}]}]}`).Not().MatchesJSONSchema(treeSchema)
+
+	// Then nothing
+}
+
+func Test_IsJSONEq_should_pass(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+
+	// When
+	assert.That(`{"a": 1, "b": [1, 2]}`).IsJSONEq(`{"b": [1, 2.0], "a": 1e0}`)
+	assert.That([]byte(`{"a": 1}`)).Not().IsJSONEq(`{"a": 2}`)
+	assert.That(strings.NewReader(`[1, 2, 3]`)).IsJSONEq(`[3, 1, 2]`, assertion.IgnoreArrayOrder())
+	assert.That(`[1, 2, 3]`).Not().IsJSONEq(`[3, 1, 2]`)
+	assert.That(`[1.0, 1.02]`).IsJSONEq(`[1.01, 1.0]`, assertion.IgnoreArrayOrder(), assertion.NumberPrecision(0.015))
+	assert.That(`{"id": 9007199254740993}`).Not().IsJSONEq(`{"id": 9007199254740992}`)
+	assert.That(`{"pi": 3.1416}`).IsJSONEq(`{"pi": 3.14}`, assertion.NumberPrecision(0.01))
+	assert.That(map[string]int{"a": 1}).IsJSONEq(`{"a": 1}`)
+	assert.That(jsonRecord(`{"a": [true, null]}`)).IsJSONEq(`{"a": [true, null]}`)
+	assert.That(jsonRecord(`{"a": [true, null]}`)).BodyIsJSONEq(`{"a": [true, null]}`)
+
+	// Then nothing
+}
+
+func Test_IsJSONEq_should_fail(t *testing.T) {
+	// Mock preparation
+	ctrl := gomock.NewController(t)
+
+	testEntries := []struct {
+		assertFunc func(assert assertion.Assert)
+		errLog     string
+	}{
+		{
+			assertFunc: func(assert assertion.Assert) {
+				assert.That(`{"items": [{"name": "a"}, {"name": "b"}], "c/d": 1, "extra": true}`).
+					IsJSONEq(`{"items": [{"name": "a"}, {"name": "c"}, {"name": "d"}], "c/d": 2, "missing": "x"}`)
+			},
+			errLog: "\nJSON value is not equal to expectation :" +
+				"\n  /c~1d : expected 2, got 1" +
+				"\n  /extra : unexpected true" +
+				"\n  /items/1/name : expected \"c\", got \"b\"" +
+				"\n  /items/2 : missing, expected {\"name\":\"d\"}" +
+				"\n  /missing : missing, expected \"x\"",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) {
+				assert.That(`[1, 2, 4, 5]`).IsJSONEq(`[3, 2, 1]`, assertion.IgnoreArrayOrder())
+			},
+			errLog: "\nJSON value is not equal to expectation :" +
+				"\n  /2 : expected 3, got 4" +
+				"\n  /3 : unexpected 5",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) { assert.That(`"a"`).IsJSONEq(`{"a": 1}`) },
+			errLog:     "\nJSON value is not equal to expectation :\n  (root) : expected {\"a\":1}, got \"a\"",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) { assert.That(`[1]`).Not().IsJSONEq(`[1.0]`) },
+			errLog:     "\nJSON value should not be equal to : [1.0]",
+		},
+	}
+
+	for _, entry := range testEntries {
+		// Given
+		tMock := mocks.NewMockPublicTB(ctrl)
+		assert := assertion.New(tMock)
+
+		// Expectation
+		tMock.EXPECT().Helper().AnyTimes()
+		tMock.EXPECT().Error(entry.errLog)
+
+		// When
+		entry.assertFunc(assert)
+	}
+}
+
+func Test_IsJSONEq_should_error(t *testing.T) {
+	// Mock preparation
+	ctrl := gomock.NewController(t)
+	tMock := mocks.NewMockPublicTB(ctrl)
+	assert := assertion.New(tMock)
+
+	// Expectation
+	tMock.EXPECT().Helper().AnyTimes()
+	tMock.EXPECT().Fatalf("\n%s", "value JSON is invalid : invalid character 'o' in literal null (expecting 'u')")
+	tMock.EXPECT().Fatalf("\n%s", "expected JSON is invalid : unexpected EOF")
+	tMock.EXPECT().Fatalf("\n%s", assertion.ErrNotOfResponseRecorderType.Error())
+
+	// When
+	assert.That(`no`).IsJSONEq(`1`)
+	assert.That(`1`).IsJSONEq(`[`)
+	assert.That(`1`).BodyIsJSONEq(`1`)
+}
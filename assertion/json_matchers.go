@@ -0,0 +1,119 @@
+package assertion
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+)
+
+// rawBytes returns the document held by v : a response body, a string, a []byte or an io.Reader content.
+// The returned boolean is false when v is not one of these types.
+func rawBytes(v interface{}) ([]byte, bool, error) {
+	switch r := v.(type) {
+	case *httptest.ResponseRecorder, *http.Response:
+		_, body, err := toResponse(r)
+		return body, true, err
+	case string:
+		return []byte(r), true, nil
+	case []byte:
+		return r, true, nil
+	case io.Reader:
+		b, err := ioutil.ReadAll(r)
+		return b, true, err
+	default:
+		return nil, false, nil
+	}
+}
+
+// jsonBytes returns the JSON document held by v (see rawBytes) or v marshaled to JSON.
+func jsonBytes(v interface{}) ([]byte, error) {
+	b, ok, err := rawBytes(v)
+	if ok || err != nil {
+		return b, err
+	}
+	return json.Marshal(v)
+}
+
+// toJSONValue decodes the JSON document held by v (see jsonBytes), numbers being decoded as json.Number.
+func toJSONValue(v interface{}) (interface{}, error) {
+	b, err := jsonBytes(v)
+	if err != nil {
+		return nil, err
+	}
+	var parsed interface{}
+	err = decodeJSON(bytes.NewBuffer(b), &parsed, []JSONDecodeOption{UseNumber()})
+	return parsed, err
+}
+
+func MatchesJSONSchema(schema string) Matcher {
+	return func(v interface{}) (MatchResult, error) {
+		s, err := parseJSONSchema(schema)
+		if err != nil {
+			return errored(err)
+		}
+		parsed, err := toJSONValue(v)
+		if err != nil {
+			return errored(err)
+		}
+		violations, err := s.validate(parsed)
+		if err != nil {
+			return errored(err)
+		}
+		if len(violations) == 0 {
+			return truthy("\nValue should not match JSON schema")
+		}
+		sb := strings.Builder{}
+		sb.WriteString("\nValue do not match JSON schema :")
+		for _, violation := range violations {
+			sb.WriteString(fmt.Sprintf("\n  %s : %s", violation.path, violation.message))
+		}
+		return falsy(sb.String())
+	}
+}
+
+func IsJSONEq(expected string, opts ...JSONEqOption) Matcher {
+	return func(v interface{}) (MatchResult, error) {
+		e, err := toJSONValue(expected)
+		if err != nil {
+			return errored(fmt.Errorf("expected JSON is invalid : %w", err))
+		}
+		got, err := toJSONValue(v)
+		if err != nil {
+			return errored(fmt.Errorf("value JSON is invalid : %w", err))
+		}
+		c := &jsonEqConfig{}
+		for _, opt := range opts {
+			opt(c)
+		}
+		diffs := c.diffs("", e, got)
+		if len(diffs) == 0 {
+			return truthy(fmt.Sprintf("\nJSON value should not be equal to : %s", jsonString(e)))
+		}
+		sb := strings.Builder{}
+		sb.WriteString("\nJSON value is not equal to expectation :")
+		for _, d := range diffs {
+			pointer := d.pointer
+			if pointer == "" {
+				pointer = "(root)"
+			}
+			sb.WriteString(fmt.Sprintf("\n  %s : %s", pointer, d.log))
+		}
+		return falsy(sb.String())
+	}
+}
+
+func BodyIsJSONEq(expected string, opts ...JSONEqOption) Matcher {
+	return func(v interface{}) (MatchResult, error) {
+		switch v.(type) {
+		case *httptest.ResponseRecorder, *http.Response:
+			return IsJSONEq(expected, opts...)(v)
+		default:
+			return errored(ErrNotOfResponseRecorderType)
+		}
+	}
+}
@@ -0,0 +1,536 @@
+package assertion
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+var errInvalidSchema = errors.New("invalid JSON schema")
+
+// jsonSchema validates JSON values against a JSON Schema (draft 7 subset) : type, enum, const,
+// properties, required, additionalProperties, patternProperties, items, additionalItems, min/maxItems,
+// uniqueItems, contains, min/maxLength, pattern, minimum, maximum, exclusiveMinimum/Maximum, multipleOf,
+// min/maxProperties, propertyNames, allOf, anyOf, oneOf, not and local $ref ("#/definitions/...").
+type jsonSchema struct {
+	root map[string]interface{}
+	// resolving holds the $ref being resolved on the current validation path, keyed by ref and instance path.
+	resolving map[string]bool
+}
+
+type schemaViolation struct {
+	path    string
+	message string
+}
+
+func parseJSONSchema(schema string) (*jsonSchema, error) {
+	var root map[string]interface{}
+	decoder := json.NewDecoder(strings.NewReader(schema))
+	decoder.UseNumber()
+	if err := decoder.Decode(&root); err != nil {
+		return nil, fmt.Errorf("%w : %v", errInvalidSchema, err)
+	}
+	return &jsonSchema{root: root, resolving: make(map[string]bool)}, nil
+}
+
+func (s *jsonSchema) validate(v interface{}) ([]schemaViolation, error) {
+	violations := make([]schemaViolation, 0)
+	err := s.validateAt("$", s.root, v, &violations)
+	return violations, err
+}
+
+func (s *jsonSchema) resolve(ref string) (map[string]interface{}, error) {
+	if !strings.HasPrefix(ref, "#") {
+		return nil, fmt.Errorf("%w : only local $ref are supported (%s)", errInvalidSchema, ref)
+	}
+	var current interface{} = s.root
+	for _, token := range strings.Split(strings.TrimPrefix(strings.TrimPrefix(ref, "#"), "/"), "/") {
+		if token == "" {
+			continue
+		}
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%w : $ref %s not found", errInvalidSchema, ref)
+		}
+		if current, ok = m[token]; !ok {
+			return nil, fmt.Errorf("%w : $ref %s not found", errInvalidSchema, ref)
+		}
+	}
+	resolved, ok := current.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%w : $ref %s is not a schema", errInvalidSchema, ref)
+	}
+	return resolved, nil
+}
+
+// subSchema returns a schema object, boolean schemas being expanded (true accepts anything, false nothing).
+func subSchema(raw interface{}) (map[string]interface{}, error) {
+	switch s := raw.(type) {
+	case map[string]interface{}:
+		return s, nil
+	case bool:
+		if s {
+			return map[string]interface{}{}, nil
+		}
+		return map[string]interface{}{"not": map[string]interface{}{}}, nil
+	default:
+		return nil, fmt.Errorf("%w : schema should be an object or a boolean, got %v", errInvalidSchema, raw)
+	}
+}
+
+func (s *jsonSchema) validateRaw(path string, raw interface{}, v interface{}, violations *[]schemaViolation) error {
+	schema, err := subSchema(raw)
+	if err != nil {
+		return err
+	}
+	return s.validateAt(path, schema, v, violations)
+}
+
+// matches returns true when v is valid against raw schema, without reporting violations.
+func (s *jsonSchema) matches(path string, raw interface{}, v interface{}) (bool, error) {
+	violations := make([]schemaViolation, 0)
+	err := s.validateRaw(path, raw, v, &violations)
+	return len(violations) == 0, err
+}
+
+func (s *jsonSchema) validateAt(path string, schema map[string]interface{}, v interface{},
+	violations *[]schemaViolation) error {
+	report := func(format string, args ...interface{}) {
+		*violations = append(*violations, schemaViolation{path: path, message: fmt.Sprintf(format, args...)})
+	}
+	if ref, ok := schema["$ref"].(string); ok {
+		resolved, err := s.resolve(ref)
+		if err != nil {
+			return err
+		}
+		// The same $ref reached again for the same instance would never consume any input
+		key := ref + "\x00" + path
+		if s.resolving[key] {
+			return fmt.Errorf("%w : $ref %s is cyclic at %s", errInvalidSchema, ref, path)
+		}
+		s.resolving[key] = true
+		defer delete(s.resolving, key)
+		// Draft 7 ignores $ref siblings
+		return s.validateAt(path, resolved, v, violations)
+	}
+	checks := []func(string, map[string]interface{}, interface{}, func(string, ...interface{}),
+		*[]schemaViolation) error{
+		s.checkType, s.checkEnum, s.checkCombinators, s.checkObject, s.checkArray, s.checkString, s.checkNumber,
+	}
+	for _, check := range checks {
+		if err := check(path, schema, v, report, violations); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func jsonType(v interface{}) string {
+	switch n := v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case json.Number:
+		if f, err := n.Float64(); err == nil && f == math.Trunc(f) {
+			return "integer"
+		}
+		return "number"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", v)
+	}
+}
+
+func (s *jsonSchema) checkType(_ string, schema map[string]interface{}, v interface{},
+	report func(string, ...interface{}), _ *[]schemaViolation) error {
+	raw, ok := schema["type"]
+	if !ok {
+		return nil
+	}
+	types := make([]string, 0)
+	switch t := raw.(type) {
+	case string:
+		types = append(types, t)
+	case []interface{}:
+		for _, item := range t {
+			if name, isString := item.(string); isString {
+				types = append(types, name)
+			}
+		}
+	default:
+		return fmt.Errorf("%w : type should be a string or an array", errInvalidSchema)
+	}
+	got := jsonType(v)
+	for _, expected := range types {
+		if expected == got || expected == "number" && got == "integer" {
+			return nil
+		}
+	}
+	report("should be of type %s, got %s", strings.Join(types, " or "), got)
+	return nil
+}
+
+func jsonEqual(a, b interface{}) bool {
+	na, aIsNumber := a.(json.Number)
+	nb, bIsNumber := b.(json.Number)
+	if aIsNumber && bIsNumber {
+		fa, errA := na.Float64()
+		fb, errB := nb.Float64()
+		return errA == nil && errB == nil && fa == fb || na == nb
+	}
+	la, aIsArray := a.([]interface{})
+	lb, bIsArray := b.([]interface{})
+	if aIsArray && bIsArray {
+		if len(la) != len(lb) {
+			return false
+		}
+		for i := range la {
+			if !jsonEqual(la[i], lb[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	ma, aIsObject := a.(map[string]interface{})
+	mb, bIsObject := b.(map[string]interface{})
+	if aIsObject && bIsObject {
+		if len(ma) != len(mb) {
+			return false
+		}
+		for k, va := range ma {
+			if vb, ok := mb[k]; !ok || !jsonEqual(va, vb) {
+				return false
+			}
+		}
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func jsonString(v interface{}) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(b)
+}
+
+func (s *jsonSchema) checkEnum(_ string, schema map[string]interface{}, v interface{},
+	report func(string, ...interface{}), _ *[]schemaViolation) error {
+	if expected, ok := schema["const"]; ok && !jsonEqual(expected, v) {
+		report("should be %s, got %s", jsonString(expected), jsonString(v))
+	}
+	raw, ok := schema["enum"]
+	if !ok {
+		return nil
+	}
+	values, ok := raw.([]interface{})
+	if !ok {
+		return fmt.Errorf("%w : enum should be an array", errInvalidSchema)
+	}
+	for _, expected := range values {
+		if jsonEqual(expected, v) {
+			return nil
+		}
+	}
+	report("should be one of %s, got %s", jsonString(values), jsonString(v))
+	return nil
+}
+
+func (s *jsonSchema) checkCombinators(path string, schema map[string]interface{}, v interface{},
+	report func(string, ...interface{}), violations *[]schemaViolation) error {
+	if all, ok := schema["allOf"].([]interface{}); ok {
+		for _, sub := range all {
+			if err := s.validateRaw(path, sub, v, violations); err != nil {
+				return err
+			}
+		}
+	}
+	if anyOf, ok := schema["anyOf"].([]interface{}); ok {
+		count, err := s.countMatching(path, anyOf, v)
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			report("should match at least one anyOf schema")
+		}
+	}
+	if oneOf, ok := schema["oneOf"].([]interface{}); ok {
+		count, err := s.countMatching(path, oneOf, v)
+		if err != nil {
+			return err
+		}
+		if count != 1 {
+			report("should match exactly one oneOf schema, matches %d", count)
+		}
+	}
+	if not, ok := schema["not"]; ok {
+		matches, err := s.matches(path, not, v)
+		if err != nil {
+			return err
+		}
+		if matches {
+			report("should not match the not schema")
+		}
+	}
+	return nil
+}
+
+func (s *jsonSchema) countMatching(path string, schemas []interface{}, v interface{}) (int, error) {
+	count := 0
+	for _, sub := range schemas {
+		matches, err := s.matches(path, sub, v)
+		if err != nil {
+			return 0, err
+		}
+		if matches {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func schemaInt(schema map[string]interface{}, key string) (int, bool) {
+	n, ok := schema[key].(json.Number)
+	if !ok {
+		return 0, false
+	}
+	i, err := n.Int64()
+	return int(i), err == nil
+}
+
+func schemaFloat(schema map[string]interface{}, key string) (float64, bool) {
+	n, ok := schema[key].(json.Number)
+	if !ok {
+		return 0, false
+	}
+	f, err := n.Float64()
+	return f, err == nil
+}
+
+var identifierRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+func childPath(path string, key string) string {
+	if identifierRe.MatchString(key) {
+		return path + "." + key
+	}
+	return fmt.Sprintf("%s[%q]", path, key)
+}
+
+func (s *jsonSchema) checkObject(path string, schema map[string]interface{}, v interface{},
+	report func(string, ...interface{}), violations *[]schemaViolation) error {
+	object, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	if required, isArray := schema["required"].([]interface{}); isArray {
+		for _, name := range required {
+			if _, found := object[fmt.Sprint(name)]; !found {
+				report("required property %s is missing", name)
+			}
+		}
+	}
+	if min, found := schemaInt(schema, "minProperties"); found && len(object) < min {
+		report("should have at least %d properties, got %d", min, len(object))
+	}
+	if max, found := schemaInt(schema, "maxProperties"); found && len(object) > max {
+		report("should have at most %d properties, got %d", max, len(object))
+	}
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	properties, _ := schema["properties"].(map[string]interface{})
+	patterns, _ := schema["patternProperties"].(map[string]interface{})
+	for _, key := range keys {
+		if names, found := schema["propertyNames"]; found {
+			if err := s.validateRaw(childPath(path, key), names, key, violations); err != nil {
+				return err
+			}
+		}
+		matched, err := s.checkProperty(path, key, object[key], properties, patterns, violations)
+		if err != nil {
+			return err
+		}
+		if additional, found := schema["additionalProperties"]; found && !matched {
+			if allowed, isBool := additional.(bool); isBool && !allowed {
+				report("additional property %s is not allowed", key)
+				continue
+			}
+			if err := s.validateRaw(childPath(path, key), additional, object[key], violations); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *jsonSchema) checkProperty(path, key string, value interface{}, properties, patterns map[string]interface{},
+	violations *[]schemaViolation) (bool, error) {
+	matched := false
+	if sub, found := properties[key]; found {
+		matched = true
+		if err := s.validateRaw(childPath(path, key), sub, value, violations); err != nil {
+			return matched, err
+		}
+	}
+	patternKeys := make([]string, 0, len(patterns))
+	for pattern := range patterns {
+		patternKeys = append(patternKeys, pattern)
+	}
+	sort.Strings(patternKeys)
+	for _, pattern := range patternKeys {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return matched, fmt.Errorf("%w : %v", errInvalidSchema, err)
+		}
+		if re.MatchString(key) {
+			matched = true
+			if err := s.validateRaw(childPath(path, key), patterns[pattern], value, violations); err != nil {
+				return matched, err
+			}
+		}
+	}
+	return matched, nil
+}
+
+func (s *jsonSchema) checkArray(path string, schema map[string]interface{}, v interface{},
+	report func(string, ...interface{}), violations *[]schemaViolation) error {
+	array, ok := v.([]interface{})
+	if !ok {
+		return nil
+	}
+	if min, found := schemaInt(schema, "minItems"); found && len(array) < min {
+		report("should have at least %d items, got %d", min, len(array))
+	}
+	if max, found := schemaInt(schema, "maxItems"); found && len(array) > max {
+		report("should have at most %d items, got %d", max, len(array))
+	}
+	if unique, _ := schema["uniqueItems"].(bool); unique {
+		for i := range array {
+			for j := i + 1; j < len(array); j++ {
+				if jsonEqual(array[i], array[j]) {
+					report("items should be unique, [%d] and [%d] are equal", i, j)
+				}
+			}
+		}
+	}
+	if contains, found := schema["contains"]; found {
+		if err := s.checkContains(path, contains, array, report); err != nil {
+			return err
+		}
+	}
+	return s.checkItems(path, schema, array, report, violations)
+}
+
+func (s *jsonSchema) checkContains(path string, contains interface{}, array []interface{},
+	report func(string, ...interface{})) error {
+	for i, item := range array {
+		matches, err := s.matches(fmt.Sprintf("%s[%d]", path, i), contains, item)
+		if err != nil {
+			return err
+		}
+		if matches {
+			return nil
+		}
+	}
+	report("should contain at least one item matching the contains schema")
+	return nil
+}
+
+func (s *jsonSchema) checkItems(path string, schema map[string]interface{}, array []interface{},
+	report func(string, ...interface{}), violations *[]schemaViolation) error {
+	items, found := schema["items"]
+	if !found {
+		return nil
+	}
+	tuple, isTuple := items.([]interface{})
+	for i, item := range array {
+		itemPath := fmt.Sprintf("%s[%d]", path, i)
+		var sub interface{} = items
+		if isTuple {
+			if i < len(tuple) {
+				sub = tuple[i]
+			} else if sub, found = schema["additionalItems"]; !found {
+				continue
+			}
+		}
+		if allowed, isBool := sub.(bool); isBool && !allowed {
+			report("additional item [%d] is not allowed", i)
+			continue
+		}
+		if err := s.validateRaw(itemPath, sub, item, violations); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *jsonSchema) checkString(_ string, schema map[string]interface{}, v interface{},
+	report func(string, ...interface{}), _ *[]schemaViolation) error {
+	str, ok := v.(string)
+	if !ok {
+		return nil
+	}
+	length := utf8.RuneCountInString(str)
+	if min, found := schemaInt(schema, "minLength"); found && length < min {
+		report("should have at least %d characters, got %d", min, length)
+	}
+	if max, found := schemaInt(schema, "maxLength"); found && length > max {
+		report("should have at most %d characters, got %d", max, length)
+	}
+	if pattern, found := schema["pattern"].(string); found {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return fmt.Errorf("%w : %v", errInvalidSchema, err)
+		}
+		if !re.MatchString(str) {
+			report("should match pattern %s, got %q", pattern, str)
+		}
+	}
+	return nil
+}
+
+func (s *jsonSchema) checkNumber(_ string, schema map[string]interface{}, v interface{},
+	report func(string, ...interface{}), _ *[]schemaViolation) error {
+	n, ok := v.(json.Number)
+	if !ok {
+		return nil
+	}
+	f, err := n.Float64()
+	if err != nil {
+		return nil
+	}
+	if min, found := schemaFloat(schema, "minimum"); found && f < min {
+		report("should be >= %v, got %s", min, n)
+	}
+	if max, found := schemaFloat(schema, "maximum"); found && f > max {
+		report("should be <= %v, got %s", max, n)
+	}
+	if min, found := schemaFloat(schema, "exclusiveMinimum"); found && f <= min {
+		report("should be > %v, got %s", min, n)
+	}
+	if max, found := schemaFloat(schema, "exclusiveMaximum"); found && f >= max {
+		report("should be < %v, got %s", max, n)
+	}
+	if multiple, found := schemaFloat(schema, "multipleOf"); found && multiple > 0 {
+		if q := f / multiple; math.Abs(q-math.Round(q)) > 1e-9 {
+			report("should be a multiple of %v, got %s", multiple, n)
+		}
+	}
+	return nil
+}
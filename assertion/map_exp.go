@@ -5,9 +5,34 @@ package assertion
 // ContainsValue(e interface{}) check if the map have an equal to e parameter value.
 //
 // ContainsKey(e interface{}) check if the map have an equal to e parameter key.
+//
+// ContainsEntry(k, e interface{}) check if the map have the k key with a value without diffs with e.
+//
+// ContainsEntries(e interface{}) and IsSuperMapOf(e interface{}) check if the map have all e (should be a map) entries.
+//
+// IsSubMapOf(e interface{}) check if all the map entries are into e (should be a map).
+//
+// ContainsAllKeys(keys ...interface{}) check if the map have all the keys.
+//
+// ContainsOnlyKeys(keys ...interface{}) check if the map have all the keys and no other.
+//
+// HasKeyMatching(m Matcher) check if at least one key of the map matches.
+//
+// AllValues(m Matcher) check if all the map values match.
+//
+// Entries values are compared with diff.Diffs, which supports non comparable values (eg. maps of slices).
+// Failure messages list missing, extra and mismatched keys.
 type MapExpectation interface {
 	ContainsValue(e interface{})
 	ContainsKey(e interface{})
+	ContainsEntry(k, e interface{})
+	ContainsEntries(e interface{})
+	IsSubMapOf(e interface{})
+	IsSuperMapOf(e interface{})
+	ContainsAllKeys(keys ...interface{})
+	ContainsOnlyKeys(keys ...interface{})
+	HasKeyMatching(m Matcher)
+	AllValues(m Matcher)
 }
 
 func (exp *expectation) ContainsValue(e interface{}) {
@@ -19,3 +44,43 @@ func (exp *expectation) ContainsKey(e interface{}) {
 	exp.t.Helper()
 	exp.Matches(ContainsKey(e))
 }
+
+func (exp *expectation) ContainsEntry(k, e interface{}) {
+	exp.t.Helper()
+	exp.Matches(ContainsEntry(k, e, noDiff))
+}
+
+func (exp *expectation) ContainsEntries(e interface{}) {
+	exp.t.Helper()
+	exp.Matches(ContainsEntries(e, noDiff))
+}
+
+func (exp *expectation) IsSubMapOf(e interface{}) {
+	exp.t.Helper()
+	exp.Matches(IsSubMapOf(e, noDiff))
+}
+
+func (exp *expectation) IsSuperMapOf(e interface{}) {
+	exp.t.Helper()
+	exp.Matches(ContainsEntries(e, noDiff))
+}
+
+func (exp *expectation) ContainsAllKeys(keys ...interface{}) {
+	exp.t.Helper()
+	exp.Matches(ContainsAllKeys(keys...))
+}
+
+func (exp *expectation) ContainsOnlyKeys(keys ...interface{}) {
+	exp.t.Helper()
+	exp.Matches(ContainsOnlyKeys(keys...))
+}
+
+func (exp *expectation) HasKeyMatching(m Matcher) {
+	exp.t.Helper()
+	exp.Matches(HasKeyMatching(m))
+}
+
+func (exp *expectation) AllValues(m Matcher) {
+	exp.t.Helper()
+	exp.Matches(AllValues(m))
+}
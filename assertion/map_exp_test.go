@@ -1,6 +1,7 @@
 package assertion_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/elethoughts-code/goasserts/assertion"
@@ -40,6 +41,43 @@ func Test_ContainsKey_should_pass(t *testing.T) {
 	// Then nothing
 }
 
+func Test_Map_entries_expectations_should_pass(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+	m := map[string][]int{"a": {1}, "b": {1, 2}, "c": nil}
+
+	// When
+	assert.That(m).ContainsEntry("b", []int{1, 2})
+	assert.That(m).Not().ContainsEntry("b", []int{2, 1})
+	assert.That(m).Not().ContainsEntry("d", []int{1})
+	assert.That(m).ContainsEntries(map[string][]int{"a": {1}, "c": nil})
+	assert.That(m).IsSuperMapOf(map[string][]int{"a": {1}})
+	assert.That(m).Not().ContainsEntries(map[string][]int{"a": {1}, "d": nil})
+	assert.That(m).IsSubMapOf(map[string][]int{"a": {1}, "b": {1, 2}, "c": nil, "d": {4}})
+	assert.That(m).Not().IsSubMapOf(map[string][]int{"a": {1}})
+	assert.That(m).ContainsAllKeys("a", "c")
+	assert.That(m).Not().ContainsAllKeys("a", "d")
+	assert.That(m).ContainsOnlyKeys("c", "a", "b")
+	assert.That(m).Not().ContainsOnlyKeys("a", "b")
+	assert.That(m).HasKeyMatching(assertion.HasPrefix("b"))
+	assert.That(m).Not().HasKeyMatching(assertion.HasPrefix("d"))
+	assert.That(m).AllValues(assertion.HasMaxLen(2))
+	assert.That(m).Not().AllValues(assertion.HasMinLen(1))
+	assert.That(map[interface{}]int{nil: 1, 2: 2}).ContainsEntry(nil, 1)
+	type name string
+	assert.That(map[int64]string{1: "a"}).ContainsAllKeys(1)
+	assert.That(map[int64]string{1: "a"}).ContainsEntry(1, "a")
+	assert.That(map[name]int{"a": 1}).ContainsAllKeys("a")
+	assert.That(map[string]int{"A": 1}).Not().ContainsAllKeys(65)
+	assert.That(map[int]int{1: 1}).Not().ContainsAllKeys(1.5)
+	assert.That(map[int8]int{44: 1}).Not().ContainsAllKeys(300)
+	assert.That(map[int64]string{1: "a", 2: "b"}).ContainsOnlyKeys(1, 2)
+	assert.That(map[name]int{"a": 1}).ContainsOnlyKeys("a")
+	assert.That(map[int64]string{1: "a", 2: "b"}).Not().ContainsOnlyKeys(1)
+
+	// Then nothing
+}
+
 func Test_Map_Matchers_should_fail(t *testing.T) {
 	// Mock preparation
 	ctrl := gomock.NewController(t)
@@ -78,6 +116,55 @@ func Test_Map_Matchers_should_fail(t *testing.T) {
 			},
 			errLog: "\nValue should not contains key : 2",
 		},
+		{
+			assertFunc: func(assert assertion.Assert) {
+				assert.That(map[string][]int{"a": {1, 2}}).ContainsEntry("a", []int{1, 3})
+			},
+			errLog: "\nValue should contain entry : [a]=[1 3]\nMismatched keys :\n  [a] Path [[1]] : values diff\nA=2\nB=3",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) {
+				assert.That(map[string]int{"a": 1, "b": 2}).ContainsEntries(map[string]int{"a": 2, "b": 2, "c": 3})
+			},
+			errLog: "\nValue should contain entries : map[a:2 b:2 c:3]\nMissing keys : [c]" +
+				"\nMismatched keys :\n  [a] Path [] : values diff\nA=1\nB=2",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) {
+				assert.That(map[string]int{"a": 1, "b": 2}).IsSubMapOf(map[string]int{"a": 1})
+			},
+			errLog: "\nValue should be a sub map of : map[a:1]\nExtra keys : [b]",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) {
+				assert.That(map[string]int{"a": 1, "c": 2}).ContainsOnlyKeys("a", "b")
+			},
+			errLog: "\nValue should contain only keys : [a b]\nMissing keys : [b]\nExtra keys : [c]",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) {
+				assert.That(map[int64]string{1: "a", 2: "b", 3: "c"}).ContainsOnlyKeys(1, 2)
+			},
+			errLog: "\nValue should contain only keys : [1 2]\nExtra keys : [3]",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) {
+				assert.That(map[string]int{"a": 1, "c": 2}).ContainsAllKeys("b")
+			},
+			errLog: "\nValue should contain all keys : [b]\nMissing keys : [b]",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) {
+				assert.That(map[string]int{"b": 1, "a": 2}).HasKeyMatching(assertion.IsEq("c"))
+			},
+			errLog: "\nValue should have a key matching. Keys : [a b]",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) {
+				assert.That(map[string]int{"b": 1, "a": 2, "c": 3}).AllValues(assertion.IsEq(1))
+			},
+			errLog: "\nAll values should match. Non matching keys : [a c]",
+		},
 	}
 
 	for _, entry := range testEntries {
@@ -109,9 +196,21 @@ func Test_Map_Matchers_should_fail_with_error(t *testing.T) {
 				assert.That("abcd").Not().ContainsValue("d")
 				assert.That("abcd").ContainsKey(1)
 				assert.That("abcd").Not().ContainsKey(1)
+				assert.That("abcd").ContainsEntries(map[string]int{})
+				assert.That(map[string]int{}).IsSubMapOf("abcd")
+				assert.That(nil).ContainsOnlyKeys("a")
 			},
 			err:   assertion.ErrNotOfMapType,
-			times: 4,
+			times: 7,
+		},
+		{
+			assertFunc: func(assert assertion.Assert) {
+				panicking := func(interface{}) (assertion.MatchResult, error) { panic("boom") }
+				assert.That(map[string]int{"a": 1}).HasKeyMatching(panicking)
+				assert.That(map[string]int{"a": 1}).AllValues(panicking)
+			},
+			err:   errors.New("[panic error occurred] boom"),
+			times: 2,
 		},
 	}
 
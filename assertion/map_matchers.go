@@ -3,6 +3,10 @@ package assertion
 import (
 	"fmt"
 	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/elethoughts-code/goasserts/diff"
 )
 
 func ContainsValue(e interface{}) Matcher {
@@ -43,3 +47,262 @@ func ContainsKey(e interface{}) Matcher {
 		}
 	}
 }
+
+func noDiff(v, e interface{}) bool {
+	return diff.Equal(v, e)
+}
+
+func toMap(v interface{}) (reflect.Value, bool) {
+	vm := reflect.ValueOf(v)
+	return vm, vm.Kind() == reflect.Map
+}
+
+// mapIndex returns the m value at key k or an invalid value when the key is not found
+// or cannot be converted to m keys type without loss (e.g. int 1 for a map[int64]V or a map[MyString]V with "a").
+func mapIndex(m reflect.Value, k interface{}) reflect.Value {
+	kv, ok := mapKey(m, k)
+	if !ok {
+		return reflect.Value{}
+	}
+	return m.MapIndex(kv)
+}
+
+// mapKey returns k as a m key following mapIndex rules, or false when k cannot be a m key.
+func mapKey(m reflect.Value, k interface{}) (reflect.Value, bool) {
+	keyType := m.Type().Key()
+	kv := reflect.ValueOf(k)
+	switch {
+	case !kv.IsValid() && keyType.Kind() == reflect.Interface:
+		return reflect.Zero(keyType), true
+	case !kv.IsValid():
+		return reflect.Value{}, false
+	case kv.Type().AssignableTo(keyType):
+		return kv, true
+	case losslessConvertible(kv, keyType):
+		return kv.Convert(keyType), true
+	default:
+		return reflect.Value{}, false
+	}
+}
+
+// losslessConvertible returns true when v converted to t converts back to v,
+// so that int 65 is never looked up as "A" nor 1.5 as 1.
+func losslessConvertible(v reflect.Value, t reflect.Type) bool {
+	if !v.Type().Comparable() || !v.Type().ConvertibleTo(t) || !t.ConvertibleTo(v.Type()) {
+		return false
+	}
+	return v.Convert(t).Convert(v.Type()).Interface() == v.Interface()
+}
+
+func sortedMapKeys(m reflect.Value) []reflect.Value {
+	keys := m.MapKeys()
+	sort.SliceStable(keys, func(i, j int) bool {
+		return diff.Compare(keys[i].Interface(), keys[j].Interface()) < 0
+	})
+	return keys
+}
+
+type entriesDiff struct {
+	missing    []interface{}
+	extra      []interface{}
+	mismatched []string
+}
+
+func (ed entriesDiff) isEmpty() bool {
+	return len(ed.missing) == 0 && len(ed.extra) == 0 && len(ed.mismatched) == 0
+}
+
+func (ed entriesDiff) String() string {
+	log := ""
+	if len(ed.missing) > 0 {
+		log += fmt.Sprintf("\nMissing keys : %v", ed.missing)
+	}
+	if len(ed.extra) > 0 {
+		log += fmt.Sprintf("\nExtra keys : %v", ed.extra)
+	}
+	if len(ed.mismatched) > 0 {
+		log += "\nMismatched keys :" + strings.Join(ed.mismatched, "")
+	}
+	return log
+}
+
+func (ed *entriesDiff) mismatch(k, v, e interface{}) {
+	diffs := diff.Diffs(v, e)
+	for _, d := range diffs {
+		ed.mismatched = append(ed.mismatched, fmt.Sprintf("\n  [%v] Path %v : %v", k, d.Path, d.Value))
+	}
+	if len(diffs) == 0 {
+		ed.mismatched = append(ed.mismatched, fmt.Sprintf("\n  [%v] Expected : %v Got : %v", k, e, v))
+	}
+}
+
+// entriesDiffs compares e entries to the vm ones. Missing keys are the e keys not found in vm,
+// extra keys are the vm keys not found in e.
+func entriesDiffs(vm, em reflect.Value, areEq func(v, e interface{}) bool, checkMissing, checkExtra bool) entriesDiff {
+	ed := entriesDiff{}
+	for _, k := range sortedMapKeys(em) {
+		vValue := mapIndex(vm, k.Interface())
+		if !vValue.IsValid() {
+			if checkMissing {
+				ed.missing = append(ed.missing, k.Interface())
+			}
+			continue
+		}
+		if v, e := vValue.Interface(), em.MapIndex(k).Interface(); !areEq(v, e) {
+			ed.mismatch(k, v, e)
+		}
+	}
+	if checkExtra {
+		for _, k := range sortedMapKeys(vm) {
+			if !mapIndex(em, k.Interface()).IsValid() {
+				ed.extra = append(ed.extra, k.Interface())
+			}
+		}
+	}
+	return ed
+}
+
+func ContainsEntry(k, e interface{}, areEq func(v, e interface{}) bool) Matcher {
+	return func(v interface{}) (MatchResult, error) {
+		vm, isMap := toMap(v)
+		if !isMap {
+			return errored(ErrNotOfMapType)
+		}
+		ed := entriesDiff{}
+		vValue := mapIndex(vm, k)
+		switch {
+		case !vValue.IsValid():
+			ed.missing = append(ed.missing, k)
+		case !areEq(vValue.Interface(), e):
+			ed.mismatch(k, vValue.Interface(), e)
+		default:
+			return truthy(fmt.Sprintf("\nValue should not contain entry : [%v]=%v", k, e))
+		}
+		return falsy(fmt.Sprintf("\nValue should contain entry : [%v]=%v", k, e) + ed.String())
+	}
+}
+
+func ContainsEntries(e interface{}, areEq func(v, e interface{}) bool) Matcher {
+	return func(v interface{}) (MatchResult, error) {
+		vm, isMap := toMap(v)
+		if !isMap {
+			return errored(ErrNotOfMapType)
+		}
+		em, isMap := toMap(e)
+		if !isMap {
+			return errored(ErrNotOfMapType)
+		}
+		if ed := entriesDiffs(vm, em, areEq, true, false); !ed.isEmpty() {
+			return falsy(fmt.Sprintf("\nValue should contain entries : %v", e) + ed.String())
+		}
+		return truthy(fmt.Sprintf("\nValue should not contain entries : %v", e))
+	}
+}
+
+func IsSubMapOf(e interface{}, areEq func(v, e interface{}) bool) Matcher {
+	return func(v interface{}) (MatchResult, error) {
+		vm, isMap := toMap(v)
+		if !isMap {
+			return errored(ErrNotOfMapType)
+		}
+		em, isMap := toMap(e)
+		if !isMap {
+			return errored(ErrNotOfMapType)
+		}
+		if ed := entriesDiffs(vm, em, areEq, false, true); !ed.isEmpty() {
+			return falsy(fmt.Sprintf("\nValue should be a sub map of : %v", e) + ed.String())
+		}
+		return truthy(fmt.Sprintf("\nValue should not be a sub map of : %v", e))
+	}
+}
+
+func keysDiffs(vm reflect.Value, keys []interface{}, checkExtra bool) entriesDiff {
+	ed := entriesDiff{}
+	// Expected keys converted as map keys, so that missing and extra keys agree
+	expected := make(map[interface{}]bool, len(keys))
+	for _, k := range keys {
+		kv, ok := mapKey(vm, k)
+		if !ok || !vm.MapIndex(kv).IsValid() {
+			ed.missing = append(ed.missing, k)
+			continue
+		}
+		expected[kv.Interface()] = true
+	}
+	if checkExtra {
+		for _, k := range sortedMapKeys(vm) {
+			if !expected[k.Interface()] {
+				ed.extra = append(ed.extra, k.Interface())
+			}
+		}
+	}
+	return ed
+}
+
+func ContainsAllKeys(keys ...interface{}) Matcher {
+	return func(v interface{}) (MatchResult, error) {
+		vm, isMap := toMap(v)
+		if !isMap {
+			return errored(ErrNotOfMapType)
+		}
+		if ed := keysDiffs(vm, keys, false); !ed.isEmpty() {
+			return falsy(fmt.Sprintf("\nValue should contain all keys : %v", keys) + ed.String())
+		}
+		return truthy(fmt.Sprintf("\nValue should not contain all keys : %v", keys))
+	}
+}
+
+func ContainsOnlyKeys(keys ...interface{}) Matcher {
+	return func(v interface{}) (MatchResult, error) {
+		vm, isMap := toMap(v)
+		if !isMap {
+			return errored(ErrNotOfMapType)
+		}
+		if ed := keysDiffs(vm, keys, true); !ed.isEmpty() {
+			return falsy(fmt.Sprintf("\nValue should contain only keys : %v", keys) + ed.String())
+		}
+		return truthy(fmt.Sprintf("\nValue should not contain only keys : %v", keys))
+	}
+}
+
+func HasKeyMatching(m Matcher) Matcher {
+	return func(v interface{}) (MatchResult, error) {
+		vm, isMap := toMap(v)
+		if !isMap {
+			return errored(ErrNotOfMapType)
+		}
+		keys := sortedMapKeys(vm)
+		for _, k := range keys {
+			mr, err := runMatcher(m, k.Interface())
+			if err != nil {
+				return errored(err)
+			}
+			if mr.Matches {
+				return truthy(fmt.Sprintf("\nValue should not have a key matching. Matching key : %v", k))
+			}
+		}
+		return falsy(fmt.Sprintf("\nValue should have a key matching. Keys : %v", keys))
+	}
+}
+
+func AllValues(m Matcher) Matcher {
+	return func(v interface{}) (MatchResult, error) {
+		vm, isMap := toMap(v)
+		if !isMap {
+			return errored(ErrNotOfMapType)
+		}
+		nonMatching := make([]interface{}, 0)
+		for _, k := range sortedMapKeys(vm) {
+			mr, err := runMatcher(m, vm.MapIndex(k).Interface())
+			if err != nil {
+				return errored(err)
+			}
+			if !mr.Matches {
+				nonMatching = append(nonMatching, k.Interface())
+			}
+		}
+		if len(nonMatching) > 0 {
+			return falsy(fmt.Sprintf("\nAll values should match. Non matching keys : %v", nonMatching))
+		}
+		return truthy("\nValues should not all match")
+	}
+}
@@ -1,6 +1,12 @@
 package assertion
 
-import "reflect"
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/elethoughts-code/goasserts/diff"
+)
 
 // MapEntry is the struct used by Entries() transformation to change a map to a slice of entries.
 type MapEntry struct {
@@ -16,10 +22,21 @@ type MapEntry struct {
 // Keys() Transform the assert value from map to a slice of its keys.
 //
 // Entries() Transform the assert value from map to a slice of its entries (MapEntry).
+//
+// ValueAt(k interface{}) Transform the assert value from map to the value of the k key.
+//
+// Values(), Keys() and Entries() slices follow the map iteration order, which is random.
+// SortedKeys() and SortedEntries() sort them by key using the diff.Compare total ordering.
+// SortedKeysWith(less) and SortedEntriesWith(less) sort them by key using a custom comparator.
 type MapTransformer interface {
 	Values() Expectation
 	Keys() Expectation
 	Entries() Expectation
+	ValueAt(k interface{}) Expectation
+	SortedKeys() Expectation
+	SortedEntries() Expectation
+	SortedKeysWith(less func(a, b interface{}) bool) Expectation
+	SortedEntriesWith(less func(a, b interface{}) bool) Expectation
 }
 
 func (exp *expectation) Values() Expectation {
@@ -87,3 +104,55 @@ func (exp *expectation) Entries() Expectation {
 	}
 	return exp
 }
+
+func (exp *expectation) ValueAt(k interface{}) Expectation {
+	if exp.v == nil {
+		return exp
+	}
+	m, isMap := toMap(exp.v)
+	if !isMap {
+		panic("[type error] value should be a map")
+	}
+	value := mapIndex(m, k)
+	if !value.IsValid() {
+		panic(fmt.Sprintf("key %v not found", k))
+	}
+	exp.v = value.Interface()
+	return exp
+}
+
+func compareLess(a, b interface{}) bool {
+	return diff.Compare(a, b) < 0
+}
+
+func (exp *expectation) SortedKeys() Expectation {
+	return exp.SortedKeysWith(compareLess)
+}
+
+func (exp *expectation) SortedEntries() Expectation {
+	return exp.SortedEntriesWith(compareLess)
+}
+
+func (exp *expectation) SortedKeysWith(less func(a, b interface{}) bool) Expectation {
+	if exp.v == nil {
+		return exp
+	}
+	exp.Keys()
+	keys := exp.v.([]interface{})
+	sort.SliceStable(keys, func(i, j int) bool {
+		return less(keys[i], keys[j])
+	})
+	return exp
+}
+
+func (exp *expectation) SortedEntriesWith(less func(a, b interface{}) bool) Expectation {
+	if exp.v == nil {
+		return exp
+	}
+	exp.Entries()
+	entries := exp.v.([]interface{})
+	sort.SliceStable(entries, func(i, j int) bool {
+		return less(entries[i].(MapEntry).Key, entries[j].(MapEntry).Key)
+	})
+	return exp
+}
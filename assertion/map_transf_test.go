@@ -91,3 +91,52 @@ func Test_Entries_panic_if_not_map(t *testing.T) {
 	}()
 	assert.That("abc").Entries().Unordered([]string{"a", "b", "c"})
 }
+
+func Test_ValueAt_should_pass_assertions(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+
+	// When
+	assert.That(nil).ValueAt("a").IsNil()
+	assert.That(map[int]string{0: "a", 1: "b"}).ValueAt(1).IsEq("b")
+	assert.That(map[string][]int{"a": {1, 2}}).ValueAt("a").ContainsExactly(1, 2)
+
+	// Then nothing
+}
+
+func Test_ValueAt_panic_if_key_not_found(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+
+	// When / Then
+	defer func() {
+		r := recover()
+		assert.That(r).IsEq("key 2 not found")
+	}()
+	assert.That(map[int]string{0: "a", 1: "b"}).ValueAt(2)
+}
+
+func Test_SortedKeys_and_SortedEntries_should_pass_assertions(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+	m := map[interface{}]string{"b": "1", 2: "2", "a": "3", 1.5: "4", true: "5", nil: "6"}
+
+	// When
+	assert.That(nil).SortedKeys().IsNil()
+	assert.That(nil).SortedEntries().IsNil()
+	assert.That(m).SortedKeys().ContainsExactly(nil, true, 1.5, 2, "a", "b")
+	assert.That(m).SortedKeys().Index(0).IsNil()
+	assert.That(map[int]string{3: "c", 1: "a", 2: "b"}).SortedEntries().ContainsExactly(
+		assertion.MapEntry{Key: 1, Value: "a"},
+		assertion.MapEntry{Key: 2, Value: "b"},
+		assertion.MapEntry{Key: 3, Value: "c"},
+	)
+	assert.That(map[int]string{3: "c", 1: "a", 2: "b"}).SortedKeysWith(func(a, b interface{}) bool {
+		return a.(int) > b.(int)
+	}).ContainsExactly(3, 2, 1)
+	assert.That(map[int]string{3: "c", 1: "a", 2: "b"}).SortedEntriesWith(func(a, b interface{}) bool {
+		return a.(int) > b.(int)
+	}).Extract("Value").ContainsExactly("c", "b", "a")
+
+	// Then nothing
+}
@@ -0,0 +1,25 @@
+package assertion
+
+// MarkupExpectation interface encloses YAML and XML documents related expectations.
+// Documents are taken from strings, []byte, io.Reader or *httptest.ResponseRecorder and *http.Response bodies.
+// Both sides are normalised then compared with the Similar expectation.
+//
+// IsYAMLEq(expected string) check if the YAML document is equal to expected (anchors are resolved,
+// map keys are converted to strings). Other values (eg. decoded YAML) are compared as is.
+//
+// IsXMLEq(expected string) check if the XML document (or *XMLNode) is equal to expected, ignoring attributes order,
+// whitespace and namespace prefixes (see XMLNode).
+type MarkupExpectation interface {
+	IsYAMLEq(expected string)
+	IsXMLEq(expected string)
+}
+
+func (exp *expectation) IsYAMLEq(expected string) {
+	exp.t.Helper()
+	exp.Matches(IsYAMLEq(expected))
+}
+
+func (exp *expectation) IsXMLEq(expected string) {
+	exp.t.Helper()
+	exp.Matches(IsXMLEq(expected))
+}
@@ -0,0 +1,97 @@
+package assertion_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/elethoughts-code/goasserts/assertion"
+	mocks "github.com/elethoughts-code/goasserts/mocks/assertion"
+	"github.com/golang/mock/gomock"
+)
+
+func Test_IsYAMLEq_should_pass(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+
+	// When
+	assert.That("b: [1, 2]\na: {c: x}").IsYAMLEq("a:\n  c: x\nb:\n  - 1\n  - 2\n")
+	assert.That("base: &base {c: 1}\nd: *base").IsYAMLEq("base: {c: 1}\nd: {c: 1}")
+	assert.That("1: one").IsYAMLEq(`"1": one`)
+	assert.That([]byte("a: 1")).Not().IsYAMLEq("a: 2")
+	assert.That(strings.NewReader("a: 1")).IsYAMLEq("a: 1")
+	assert.That(map[interface{}]interface{}{"a": []interface{}{1}}).IsYAMLEq("a: [1]")
+	assert.That(map[string]int{"a": 1}).IsYAMLEq("a: 1")
+
+	// Then nothing
+}
+
+func Test_IsXMLEq_should_pass(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+
+	// When
+	assert.That(`<a x="1" y="2"><b>text</b></a>`).IsXMLEq(`<a y="2" x="1">
+		<b>  text  </b>
+	</a>`)
+	assert.That(`<p:a xmlns:p="urn:test"><p:b/></p:a>`).IsXMLEq(`<a xmlns="urn:test"><b></b></a>`)
+	assert.That(`<a xmlns="urn:one"/>`).Not().IsXMLEq(`<a xmlns="urn:two"/>`)
+	assert.That([]byte(`<a>1</a>`)).Not().IsXMLEq(`<a>2</a>`)
+	assert.That(record(200, nil, `<a><b/></a>`)).IsXMLEq(`<a><b/></a>`)
+
+	// Then nothing
+}
+
+func Test_Markup_expectations_should_fail(t *testing.T) {
+	// Mock preparation
+	ctrl := gomock.NewController(t)
+
+	testEntries := []struct {
+		assertFunc func(assert assertion.Assert)
+		errLog     string
+	}{
+		{
+			assertFunc: func(assert assertion.Assert) { assert.That("a: 2\nb: [1]").IsYAMLEq("a: 1\nb: [1, 2]") },
+			errLog: "Value have following dissimilarities with expectation :" +
+				"\nPath [[a]] : values diff\nA=2\nB=1\nPath [[b] [1]] : element inserted : 2",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) {
+				assert.That(`<a x="2"><b>u</b></a>`).IsXMLEq(`<a x="1"><b>t</b></a>`)
+			},
+			errLog: "Value have following dissimilarities with expectation :" +
+				"\nPath [[Attributes] [x]] : values diff\nA=2\nB=1" +
+				"\nPath [[Children] [0] [Text]] : values diff\nA=u\nB=t",
+		},
+	}
+
+	for _, entry := range testEntries {
+		// Given
+		tMock := mocks.NewMockPublicTB(ctrl)
+		assert := assertion.New(tMock)
+
+		// Expectation
+		tMock.EXPECT().Helper().AnyTimes()
+		tMock.EXPECT().Error(entry.errLog)
+
+		// When
+		entry.assertFunc(assert)
+	}
+}
+
+func Test_Markup_expectations_should_error(t *testing.T) {
+	// Mock preparation
+	ctrl := gomock.NewController(t)
+	tMock := mocks.NewMockPublicTB(ctrl)
+	assert := assertion.New(tMock)
+
+	// Expectation
+	tMock.EXPECT().Helper().AnyTimes()
+	tMock.EXPECT().Fatalf("\n%s", "value XML is invalid : "+assertion.ErrNotOfXMLType.Error())
+	tMock.EXPECT().Fatalf("\n%s", "value XML is invalid : XML document has no root element")
+	tMock.EXPECT().Fatalf("\n%s", "expected YAML is invalid : yaml: line 1: did not find expected node content")
+
+	// When
+	assert.That(123).IsXMLEq(`<a/>`)
+	assert.That(``).IsXMLEq(`<a/>`)
+	assert.That(`a: 1`).IsYAMLEq(`[`)
+}
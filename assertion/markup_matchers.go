@@ -0,0 +1,81 @@
+package assertion
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+)
+
+// normalizeYAML converts gopkg.in/yaml.v2 map[interface{}]interface{} maps to map[string]interface{} maps.
+func normalizeYAML(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(t))
+		for k, value := range t {
+			m[fmt.Sprint(k)] = normalizeYAML(value)
+		}
+		return m
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(t))
+		for k, value := range t {
+			m[k] = normalizeYAML(value)
+		}
+		return m
+	case []interface{}:
+		s := make([]interface{}, len(t))
+		for i, value := range t {
+			s[i] = normalizeYAML(value)
+		}
+		return s
+	default:
+		return v
+	}
+}
+
+func parseYAML(b []byte) (interface{}, error) {
+	var parsed interface{}
+	if err := yaml.Unmarshal(b, &parsed); err != nil {
+		return nil, err
+	}
+	return normalizeYAML(parsed), nil
+}
+
+// toYAMLValue parses the YAML document held by v (see rawBytes), other values are normalized as is.
+func toYAMLValue(v interface{}) (interface{}, error) {
+	b, ok, err := rawBytes(v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return normalizeYAML(v), nil
+	}
+	return parseYAML(b)
+}
+
+func IsYAMLEq(expected string) Matcher {
+	return func(v interface{}) (MatchResult, error) {
+		e, err := parseYAML([]byte(expected))
+		if err != nil {
+			return errored(fmt.Errorf("expected YAML is invalid : %w", err))
+		}
+		got, err := toYAMLValue(v)
+		if err != nil {
+			return errored(fmt.Errorf("value YAML is invalid : %w", err))
+		}
+		return SimilarWith(e)(got)
+	}
+}
+
+func IsXMLEq(expected string) Matcher {
+	return func(v interface{}) (MatchResult, error) {
+		e, err := parseXML([]byte(expected))
+		if err != nil {
+			return errored(fmt.Errorf("expected XML is invalid : %w", err))
+		}
+		got, err := toXMLNode(v)
+		if err != nil {
+			return errored(fmt.Errorf("value XML is invalid : %w", err))
+		}
+		return SimilarWith(e)(got)
+	}
+}
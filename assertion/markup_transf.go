@@ -0,0 +1,44 @@
+package assertion
+
+import "bytes"
+
+// MarkupTransformer interface encloses YAML and XML related transformations.
+// All transformations return the same expectation interface to pile in calls (Fluent API).
+//
+// BodyToXML() changes a *httptest.ResponseRecorder or *http.Response value to its body parsed as *XMLNode.
+//
+// BodyToYAML() changes a *httptest.ResponseRecorder or *http.Response value to its body parsed as YAML
+// (maps having string keys).
+//
+// XPath(expr string) changes a *XMLNode (or XML document) value to the slice of nodes or values selected by expr
+// (see XMLNode.XPath for the supported subset).
+type MarkupTransformer interface {
+	BodyToXML() Expectation
+	BodyToYAML() Expectation
+	XPath(expr string) Expectation
+}
+
+func (exp *expectation) BodyToXML() Expectation {
+	return exp.DecodeBody(func(body *bytes.Buffer) (interface{}, error) {
+		return parseXML(body.Bytes())
+	})
+}
+
+func (exp *expectation) BodyToYAML() Expectation {
+	return exp.DecodeBody(func(body *bytes.Buffer) (interface{}, error) {
+		return parseYAML(body.Bytes())
+	})
+}
+
+func (exp *expectation) XPath(expr string) Expectation {
+	node, err := toXMLNode(exp.v)
+	if err != nil {
+		panic(err)
+	}
+	selected, err := node.XPath(expr)
+	if err != nil {
+		panic(err)
+	}
+	exp.v = selected
+	return exp
+}
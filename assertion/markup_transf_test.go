@@ -0,0 +1,54 @@
+package assertion_test
+
+import (
+	"testing"
+
+	"github.com/elethoughts-code/goasserts/assertion"
+)
+
+const library = `<library xmlns:b="urn:books">
+	<b:book id="1" lang="en"><title>Dune</title><author>Herbert</author></b:book>
+	<b:book id="2" lang="fr"><title>Candide</title><author>Voltaire</author></b:book>
+	<magazine id="3"><title>Wired</title></magazine>
+</library>`
+
+func Test_BodyToXML_BodyToYAML_should_pass_assertions(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+
+	// When / Then
+	assert.That(record(200, nil, library)).BodyToXML().Attr("Name").IsEq("library")
+	assert.That(record(200, nil, library)).BodyToXML().Attr("Children").HasLen(3)
+	assert.That(record(200, nil, "a: {b: [1, 2]}")).BodyToYAML().
+		IsDeepEq(map[string]interface{}{"a": map[string]interface{}{"b": []interface{}{1, 2}}})
+}
+
+func Test_XPath_should_pass_assertions(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+
+	// When / Then
+	assert.That(library).XPath("/library/book").HasLen(2)
+	assert.That(library).XPath("//title/text()").IsDeepEq([]interface{}{"Dune", "Candide", "Wired"})
+	assert.That(library).XPath("/library/*/@id").IsDeepEq([]interface{}{"1", "2", "3"})
+	assert.That(library).XPath("book[2]/title/text()").IsDeepEq([]interface{}{"Candide"})
+	assert.That(library).XPath("book[last()]/@lang").IsDeepEq([]interface{}{"fr"})
+	assert.That(library).XPath("//book[@lang='en']/author/text()").IsDeepEq([]interface{}{"Herbert"})
+	assert.That(library).XPath(`//*[title="Wired"]/@id`).IsDeepEq([]interface{}{"3"})
+	assert.That(library).XPath("//book[@isbn]").IsEmpty()
+	assert.That(library).XPath("//author/../@id").IsDeepEq([]interface{}{"1", "2"})
+	assert.That(library).XPath("/library/magazine").First().IsXMLEq(`<magazine id="3"><title>Wired</title></magazine>`)
+	assert.That(library).XPath("/library/magazine").First().XPath("title/text()").IsDeepEq([]interface{}{"Wired"})
+}
+
+func Test_XPath_panic_on_invalid_expression(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+
+	// When / Then
+	defer func() {
+		r := recover()
+		assert.That(r).HasErrorMessage(`invalid XPath expression "//title/text()/a" : text() should be the last step`)
+	}()
+	assert.That(library).XPath("//title/text()/a")
+}
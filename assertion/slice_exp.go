@@ -11,13 +11,34 @@ import (
 // Contains(e interface{}) check if e parameter is into the slice.
 //
 // Unordered(e interface{}) check if all elements into the e parameter (should be a slice)
-// are into the slice regardless of the order.
+// are into the slice regardless of the order. Elements are paired one to one, and on failure
+// missing elements (with their closest unpaired value and its diffs) and unexpected elements are reported.
 //
 // All(m Matcher) check if all elements of the value slice matches.
 //
 // AtLeast(n int, m Matcher) check if at least n elements from the value slice matches.
 //
 // Any(m Matcher) check if at any element from the value slice matches.
+//
+// IsSorted(less func(a, b interface{}) bool) check if the slice is sorted according to less.
+//
+// IsSortedBy(path ...interface{}) check if the slice is sorted by the attribute found at path (see SortBy).
+//
+// ContainsInOrder(e ...interface{}) check if e elements are into the slice in the same order (gaps allowed).
+//
+// ContainsSubsequence(e interface{}) check if e elements (should be a slice) are into the slice as a contiguous run.
+//
+// StartsWith(e ...interface{}) and EndsWith(e ...interface{}) check the first or last elements of the slice.
+//
+// ContainsExactly(e ...interface{}) check if the slice have exactly e elements in the same order.
+//
+// HasNoDuplicates() check if all elements of the slice are distinct.
+//
+// IsSubsetOf(e interface{}) and IsSupersetOf(e interface{}) check set inclusion between the slice and e.
+//
+// ContainsNone(e ...interface{}) check if none of e elements are into the slice.
+//
+// Sequence expectations use reflect.DeepEqual to compare elements and apply on arrays as well as slices.
 type SliceExpectation interface {
 	Contains(e interface{})
 	Unordered(e interface{})
@@ -27,6 +48,17 @@ type SliceExpectation interface {
 	AtLeast(n int, m func(v interface{}) bool)
 	Any(m func(v interface{}) bool)
 	Every(matchers []func(v interface{}) bool)
+	IsSorted(less func(a, b interface{}) bool)
+	IsSortedBy(path ...interface{})
+	ContainsInOrder(e ...interface{})
+	ContainsSubsequence(e interface{})
+	StartsWith(e ...interface{})
+	EndsWith(e ...interface{})
+	ContainsExactly(e ...interface{})
+	HasNoDuplicates()
+	IsSubsetOf(e interface{})
+	IsSupersetOf(e interface{})
+	ContainsNone(e ...interface{})
 }
 
 func (exp *expectation) Contains(e interface{}) {
@@ -79,3 +111,58 @@ func (exp *expectation) Every(matchers []func(v interface{}) bool) {
 		return currentM(v)
 	}))
 }
+
+func (exp *expectation) IsSorted(less func(a, b interface{}) bool) {
+	exp.t.Helper()
+	exp.Matches(IsSorted(less))
+}
+
+func (exp *expectation) IsSortedBy(path ...interface{}) {
+	exp.t.Helper()
+	exp.Matches(IsSortedBy(path...))
+}
+
+func (exp *expectation) ContainsInOrder(e ...interface{}) {
+	exp.t.Helper()
+	exp.Matches(ContainsInOrder(e...))
+}
+
+func (exp *expectation) ContainsSubsequence(e interface{}) {
+	exp.t.Helper()
+	exp.Matches(ContainsSubsequence(e))
+}
+
+func (exp *expectation) StartsWith(e ...interface{}) {
+	exp.t.Helper()
+	exp.Matches(StartsWith(e...))
+}
+
+func (exp *expectation) EndsWith(e ...interface{}) {
+	exp.t.Helper()
+	exp.Matches(EndsWith(e...))
+}
+
+func (exp *expectation) ContainsExactly(e ...interface{}) {
+	exp.t.Helper()
+	exp.Matches(ContainsExactly(e...))
+}
+
+func (exp *expectation) HasNoDuplicates() {
+	exp.t.Helper()
+	exp.Matches(HasNoDuplicates())
+}
+
+func (exp *expectation) IsSubsetOf(e interface{}) {
+	exp.t.Helper()
+	exp.Matches(IsSubsetOf(e))
+}
+
+func (exp *expectation) IsSupersetOf(e interface{}) {
+	exp.t.Helper()
+	exp.Matches(IsSupersetOf(e))
+}
+
+func (exp *expectation) ContainsNone(e ...interface{}) {
+	exp.t.Helper()
+	exp.Matches(ContainsNone(e...))
+}
@@ -170,15 +170,15 @@ func Test_Slice_Matchers_should_fail(t *testing.T) {
 		},
 		{
 			assertFunc: func(assert assertion.Assert) { assert.That([]string{}).Unordered([]string{"b"}) },
-			errLog:     fmt.Sprintf("\nValue should contains all elements : %v", []string{"b"}),
+			errLog:     "\nValue should contains all elements : [b]\nMissing elements :\n  [0]=b",
 		},
 		{
 			assertFunc: func(assert assertion.Assert) { assert.That([]string{}).UnorderedDeepEq([]string{"b"}) },
-			errLog:     fmt.Sprintf("\nValue should contains all elements : %v", []string{"b"}),
+			errLog:     "\nValue should contains all elements : [b]\nMissing elements :\n  [0]=b",
 		},
 		{
 			assertFunc: func(assert assertion.Assert) { assert.That([]string{}).UnorderedNoDiff([]string{"b"}) },
-			errLog:     fmt.Sprintf("\nValue should contains all elements : %v", []string{"b"}),
+			errLog:     "\nValue should contains all elements : [b]\nMissing elements :\n  [0]=b",
 		},
 		{
 			assertFunc: func(assert assertion.Assert) { assert.That([]string{"b", "c"}).Not().Unordered([]string{"b", "c"}) },
@@ -186,7 +186,19 @@ func Test_Slice_Matchers_should_fail(t *testing.T) {
 		},
 		{
 			assertFunc: func(assert assertion.Assert) { assert.That([]string{"b", "c"}).Unordered([]string{"a", "b"}) },
-			errLog:     fmt.Sprintf("\nElement [%d]=%v not found", 0, "a"),
+			errLog: "\nValue should contains all elements : [a b]\nMissing elements :\n  [0]=a" +
+				"\n    closest value [1]=c\n    Path [] : values diff\nA=c\nB=a\nUnexpected elements :\n  [1]=c",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) {
+				assert.That([]string{"a", "b", "b"}).Unordered([]string{"a", "a", "b"})
+			},
+			errLog: "\nValue should contains all elements : [a a b]\nMissing elements :" +
+				"\n  [1]=a (count mismatch: matching value element(s) [0] already paired)\nUnexpected elements :\n  [2]=b",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) { assert.That([]string{"a", "b", "c"}).Unordered([]string{"b", "a"}) },
+			errLog:     "\nValue should contains all elements : [b a]\nUnexpected elements :\n  [2]=c",
 		},
 		{
 			assertFunc: func(assert assertion.Assert) {
@@ -296,5 +308,121 @@ func Test_Every_should_pass(t *testing.T) {
 		func(v interface{}) bool { return v == "a" },
 	})
 
+	// Pairing is not greedy : first matcher should be paired to "b"
+	assert.That([]string{"a", "b"}).Every([]func(interface{}) bool{
+		func(v interface{}) bool { return v == "a" || v == "b" },
+		func(v interface{}) bool { return v == "a" },
+	})
+	assert.That([]string{"a", "a"}).Not().Every([]func(interface{}) bool{
+		func(v interface{}) bool { return v == "a" || v == "b" },
+		func(v interface{}) bool { return v == "b" },
+	})
+
+	// Then nothing
+}
+
+func Test_Sequence_expectations_should_pass(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+
+	// When
+	assert.That([]int{1, 2, 2, 3}).IsSorted(func(a, b interface{}) bool { return a.(int) < b.(int) })
+	assert.That([]int{1, 3, 2}).Not().IsSorted(func(a, b interface{}) bool { return a.(int) < b.(int) })
+	assert.That(users).Not().IsSortedBy("Age")
+	assert.That(users).SortBy("Age").IsSortedBy("Age")
+	assert.That([3]string{"a", "b", "c"}).IsSortedBy()
+
+	assert.That([]string{"a", "b", "c", "d"}).ContainsInOrder("a", "c", "d")
+	assert.That([]string{"a", "b", "c", "d"}).Not().ContainsInOrder("c", "a")
+	assert.That([]string{"a", "b", "c", "d"}).ContainsSubsequence([]string{"b", "c"})
+	assert.That([]string{"a", "b", "c", "d"}).Not().ContainsSubsequence([]string{"a", "c"})
+	assert.That([]string{"a", "b", "c", "d"}).StartsWith("a", "b")
+	assert.That([]string{"a", "b", "c", "d"}).Not().StartsWith("b")
+	assert.That([]string{"a", "b", "c", "d"}).EndsWith("c", "d")
+	assert.That([4]string{"a", "b", "c", "d"}).Not().EndsWith("a", "b", "c", "d", "e")
+	assert.That([]string{"a", "b"}).ContainsExactly("a", "b")
+	assert.That([]string{"a", "b"}).Not().ContainsExactly("b", "a")
+	assert.That([][]int{{1}, {2}}).HasNoDuplicates()
+	assert.That([][]int{{1}, {2}, {1}}).Not().HasNoDuplicates()
+	assert.That([]string{"a", "b"}).IsSubsetOf([]string{"c", "b", "a"})
+	assert.That([]string{"a", "d"}).Not().IsSubsetOf([]string{"c", "b", "a"})
+	assert.That([]string{"c", "b", "a"}).IsSupersetOf([2]string{"a", "b"})
+	assert.That([]string{"a", "b"}).ContainsNone("c", "d")
+	assert.That([]string{"a", "b"}).Not().ContainsNone("c", "b")
+
 	// Then nothing
 }
+
+func Test_Sequence_expectations_should_fail(t *testing.T) {
+	// Mock preparation
+	ctrl := gomock.NewController(t)
+
+	testEntries := []struct {
+		assertFunc func(assert assertion.Assert)
+		errLog     string
+	}{
+		{
+			assertFunc: func(assert assertion.Assert) { assert.That([]int{1, 3, 2}).IsSortedBy() },
+			errLog:     "\nValue is not sorted. Elements [1]=3 and [2]=2 are out of order",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) { assert.That([]string{"a", "b", "c"}).ContainsInOrder("b", "a") },
+			errLog:     "\nElement [1]=a not found in order from value index 2",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) {
+				assert.That([]string{"a", "b"}).ContainsSubsequence([]string{"b", "a"})
+			},
+			errLog: fmt.Sprintf("\nValue should contain sequence : %v", []string{"b", "a"}),
+		},
+		{
+			assertFunc: func(assert assertion.Assert) { assert.That([]string{"a", "b"}).StartsWith("a", "c") },
+			errLog:     "\nValue should start with : [a c]. Element [1] differs.\nExpected : c\nGot : b",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) { assert.That([]string{"a"}).StartsWith("a", "c") },
+			errLog:     "\nValue is shorter than expected prefix.\nExpected : [a c]\nGot : [a]",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) { assert.That([]string{"a", "b", "c"}).EndsWith("a", "c") },
+			errLog:     "\nValue should end with : [a c]. Element [1] differs.\nExpected : a\nGot : b",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) { assert.That([]string{"a", "b"}).ContainsExactly("a") },
+			errLog:     "\nValue should contain exactly : [a]. Length differs.\nExpected : 1\nGot : 2",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) { assert.That([]string{"a", "b"}).ContainsExactly("a", "c") },
+			errLog:     "\nValue should contain exactly : [a c]. Element [1] differs.\nExpected : c\nGot : b",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) { assert.That([]string{"a", "b", "c", "b"}).HasNoDuplicates() },
+			errLog:     "\nValue have duplicated elements at indexes [1] and [3] : b",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) { assert.That([]string{"a", "d", "e"}).IsSubsetOf([]string{"a"}) },
+			errLog:     "\nValue is not a subset of : [a]. Elements not found : [1]=d, [2]=e",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) { assert.That([]string{"a"}).IsSupersetOf([]string{"a", "b"}) },
+			errLog:     "\nValue is not a superset of : [a b]. Missing elements : [1]=b",
+		},
+		{
+			assertFunc: func(assert assertion.Assert) { assert.That([]string{"a", "b", "c"}).ContainsNone("c", "a") },
+			errLog:     "\nValue should not contain any of : [c a]. Found : [0]=a, [2]=c",
+		},
+	}
+
+	for _, entry := range testEntries {
+		// Given
+		tMock := mocks.NewMockPublicTB(ctrl)
+		assert := assertion.New(tMock)
+
+		// Expectation
+		tMock.EXPECT().Helper().AnyTimes()
+		tMock.EXPECT().Error(entry.errLog)
+
+		// When
+		entry.assertFunc(assert)
+	}
+}
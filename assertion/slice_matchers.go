@@ -3,6 +3,9 @@ package assertion
 import (
 	"fmt"
 	"reflect"
+	"strings"
+
+	"github.com/elethoughts-code/goasserts/diff"
 )
 
 func toSlice(v interface{}) ([]interface{}, bool) {
@@ -10,7 +13,7 @@ func toSlice(v interface{}) ([]interface{}, bool) {
 		return nil, true
 	}
 	switch reflect.TypeOf(v).Kind() {
-	case reflect.Slice:
+	case reflect.Slice, reflect.Array:
 		s := reflect.ValueOf(v)
 		is := make([]interface{}, s.Len())
 		for i := 0; i < s.Len(); i++ {
@@ -38,6 +41,98 @@ func Contains(e interface{}) Matcher {
 	}
 }
 
+// unorderedMatching pairs value and expectation elements using a maximum bipartite matching
+// (Kuhn's augmenting paths), so that an early greedy pairing never hides a valid assignment.
+// It returns, for each value element, the paired expectation index (or -1) and reciprocally.
+func unorderedMatching(iv, ie []interface{}, areEq func(v, e interface{}) bool) ([]int, []int, [][]int) {
+	candidates := make([][]int, len(ie))
+	for j, expectedItem := range ie {
+		for i, sliceItem := range iv {
+			if areEq(sliceItem, expectedItem) {
+				candidates[j] = append(candidates[j], i)
+			}
+		}
+	}
+	vMatch := make([]int, len(iv))
+	for i := range vMatch {
+		vMatch[i] = -1
+	}
+	eMatch := make([]int, len(ie))
+	var augment func(j int, seen []bool) bool
+	augment = func(j int, seen []bool) bool {
+		for _, i := range candidates[j] {
+			if seen[i] {
+				continue
+			}
+			seen[i] = true
+			if vMatch[i] < 0 || augment(vMatch[i], seen) {
+				vMatch[i] = j
+				eMatch[j] = i
+				return true
+			}
+		}
+		return false
+	}
+	for j := range ie {
+		eMatch[j] = -1
+		augment(j, make([]bool, len(iv)))
+	}
+	return vMatch, eMatch, candidates
+}
+
+// closestCandidate returns the unpaired value element index having the fewest diff.Diffs with e.
+func closestCandidate(iv []interface{}, vMatch []int, e interface{}) (int, []diff.Diff) {
+	closest := -1
+	var closestDiffs []diff.Diff
+	if e != nil && reflect.TypeOf(e).Kind() == reflect.Func {
+		return closest, nil
+	}
+	for i, sliceItem := range iv {
+		if vMatch[i] >= 0 {
+			continue
+		}
+		diffs := diff.Diffs(sliceItem, e)
+		if closest < 0 || len(diffs) < len(closestDiffs) {
+			closest, closestDiffs = i, diffs
+		}
+	}
+	return closest, closestDiffs
+}
+
+func unorderedReport(e interface{}, iv, ie []interface{}, vMatch, eMatch []int, candidates [][]int) string {
+	log := fmt.Sprintf("\nValue should contains all elements : %v", e)
+	missing := ""
+	for j, expectedItem := range ie {
+		if eMatch[j] >= 0 {
+			continue
+		}
+		missing += fmt.Sprintf("\n  [%d]=%v", j, expectedItem)
+		if len(candidates[j]) > 0 {
+			missing += fmt.Sprintf(" (count mismatch: matching value element(s) %v already paired)", candidates[j])
+			continue
+		}
+		if closest, diffs := closestCandidate(iv, vMatch, expectedItem); closest >= 0 {
+			missing += fmt.Sprintf("\n    closest value [%d]=%v", closest, iv[closest])
+			for _, d := range diffs {
+				missing += fmt.Sprintf("\n    Path %v : %v", d.Path, d.Value)
+			}
+		}
+	}
+	if missing != "" {
+		log += "\nMissing elements :" + missing
+	}
+	unexpected := ""
+	for i, sliceItem := range iv {
+		if vMatch[i] < 0 {
+			unexpected += fmt.Sprintf("\n  [%d]=%v", i, sliceItem)
+		}
+	}
+	if unexpected != "" {
+		log += "\nUnexpected elements :" + unexpected
+	}
+	return log
+}
+
 func Unordered(e interface{}, areEq func(v, e interface{}) bool) Matcher {
 	return func(v interface{}) (MatchResult, error) {
 		iv, isSlice := toSlice(v)
@@ -49,22 +144,15 @@ func Unordered(e interface{}, areEq func(v, e interface{}) bool) Matcher {
 			return errored(ErrNotOfSliceType)
 		}
 
-		if len(ie) != len(iv) {
-			return falsy(fmt.Sprintf("\nValue should contains all elements : %v", e))
-		}
-
-		for i, expectedItem := range ie {
-			found := false
-			for _, sliceItem := range iv {
-				if areEq(sliceItem, expectedItem) {
-					found = true
-					break
-				}
-			}
-			if !found {
-				return falsy(fmt.Sprintf("\nElement [%d]=%v not found", i, expectedItem))
+		vMatch, eMatch, candidates := unorderedMatching(iv, ie, areEq)
+		for _, i := range eMatch {
+			if i < 0 {
+				return falsy(unorderedReport(e, iv, ie, vMatch, eMatch, candidates))
 			}
 		}
+		if len(iv) != len(ie) {
+			return falsy(unorderedReport(e, iv, ie, vMatch, eMatch, candidates))
+		}
 		return truthy(fmt.Sprintf("\nValue should not contain all elements : %v", e))
 	}
 }
@@ -114,3 +202,229 @@ func AtLeast(n int, matcher func(v interface{}) bool) Matcher {
 		return truthy(fmt.Sprintf("\nMatcher should not apply to %d element(s) or more", n))
 	}
 }
+
+func formatElements(indexes []int, elements []interface{}) string {
+	parts := make([]string, len(indexes))
+	for i, index := range indexes {
+		parts[i] = fmt.Sprintf("[%d]=%v", index, elements[i])
+	}
+	return strings.Join(parts, ", ")
+}
+
+func indexOf(s []interface{}, e interface{}, from int) int {
+	for i := from; i < len(s); i++ {
+		if reflect.DeepEqual(s[i], e) {
+			return i
+		}
+	}
+	return -1
+}
+
+// firstMismatch returns the index of the first element of s (starting from offset) not equal
+// to its e counterpart, or -1 if the whole e sequence is found at offset.
+func firstMismatch(s, e []interface{}, offset int) int {
+	for i, item := range e {
+		if !reflect.DeepEqual(s[offset+i], item) {
+			return offset + i
+		}
+	}
+	return -1
+}
+
+func IsSorted(less func(a, b interface{}) bool) Matcher {
+	return func(v interface{}) (MatchResult, error) {
+		iv, isSlice := toSlice(v)
+		if !isSlice {
+			return errored(ErrNotOfSliceType)
+		}
+		for i := 1; i < len(iv); i++ {
+			if less(iv[i], iv[i-1]) {
+				return falsy(fmt.Sprintf("\nValue is not sorted. Elements [%d]=%v and [%d]=%v are out of order",
+					i-1, iv[i-1], i, iv[i]))
+			}
+		}
+		return truthy("\nValue should not be sorted")
+	}
+}
+
+func IsSortedBy(path ...interface{}) Matcher {
+	return IsSorted(func(a, b interface{}) bool {
+		return compareBasics(elemFromPath(a, path), elemFromPath(b, path)) < 0
+	})
+}
+
+func ContainsInOrder(e ...interface{}) Matcher {
+	return func(v interface{}) (MatchResult, error) {
+		iv, isSlice := toSlice(v)
+		if !isSlice {
+			return errored(ErrNotOfSliceType)
+		}
+		from := 0
+		for i, expectedItem := range e {
+			index := indexOf(iv, expectedItem, from)
+			if index < 0 {
+				return falsy(fmt.Sprintf("\nElement [%d]=%v not found in order from value index %d", i, expectedItem, from))
+			}
+			from = index + 1
+		}
+		return truthy(fmt.Sprintf("\nValue should not contain elements in order : %v", e))
+	}
+}
+
+func ContainsSubsequence(e interface{}) Matcher {
+	return func(v interface{}) (MatchResult, error) {
+		iv, isSlice := toSlice(v)
+		if !isSlice {
+			return errored(ErrNotOfSliceType)
+		}
+		ie, isSlice := toSlice(e)
+		if !isSlice {
+			return errored(ErrNotOfSliceType)
+		}
+		for offset := 0; offset+len(ie) <= len(iv); offset++ {
+			if firstMismatch(iv, ie, offset) < 0 {
+				return truthy(fmt.Sprintf("\nValue should not contain sequence : %v. Found at index %d", e, offset))
+			}
+		}
+		return falsy(fmt.Sprintf("\nValue should contain sequence : %v", e))
+	}
+}
+
+func StartsWith(e ...interface{}) Matcher {
+	return func(v interface{}) (MatchResult, error) {
+		iv, isSlice := toSlice(v)
+		if !isSlice {
+			return errored(ErrNotOfSliceType)
+		}
+		if len(e) > len(iv) {
+			return falsy(fmt.Sprintf("\nValue is shorter than expected prefix.\nExpected : %v\nGot : %v", e, iv))
+		}
+		if index := firstMismatch(iv, e, 0); index >= 0 {
+			return falsy(fmt.Sprintf("\nValue should start with : %v. Element [%d] differs.\nExpected : %v\nGot : %v",
+				e, index, e[index], iv[index]))
+		}
+		return truthy(fmt.Sprintf("\nValue should not start with : %v", e))
+	}
+}
+
+func EndsWith(e ...interface{}) Matcher {
+	return func(v interface{}) (MatchResult, error) {
+		iv, isSlice := toSlice(v)
+		if !isSlice {
+			return errored(ErrNotOfSliceType)
+		}
+		if len(e) > len(iv) {
+			return falsy(fmt.Sprintf("\nValue is shorter than expected suffix.\nExpected : %v\nGot : %v", e, iv))
+		}
+		offset := len(iv) - len(e)
+		if index := firstMismatch(iv, e, offset); index >= 0 {
+			return falsy(fmt.Sprintf("\nValue should end with : %v. Element [%d] differs.\nExpected : %v\nGot : %v",
+				e, index, e[index-offset], iv[index]))
+		}
+		return truthy(fmt.Sprintf("\nValue should not end with : %v", e))
+	}
+}
+
+func ContainsExactly(e ...interface{}) Matcher {
+	return func(v interface{}) (MatchResult, error) {
+		iv, isSlice := toSlice(v)
+		if !isSlice {
+			return errored(ErrNotOfSliceType)
+		}
+		if len(e) != len(iv) {
+			return falsy(fmt.Sprintf("\nValue should contain exactly : %v. Length differs.\nExpected : %d\nGot : %d",
+				e, len(e), len(iv)))
+		}
+		if index := firstMismatch(iv, e, 0); index >= 0 {
+			return falsy(fmt.Sprintf("\nValue should contain exactly : %v. Element [%d] differs.\nExpected : %v\nGot : %v",
+				e, index, e[index], iv[index]))
+		}
+		return truthy(fmt.Sprintf("\nValue should not contain exactly : %v", e))
+	}
+}
+
+func HasNoDuplicates() Matcher {
+	return func(v interface{}) (MatchResult, error) {
+		iv, isSlice := toSlice(v)
+		if !isSlice {
+			return errored(ErrNotOfSliceType)
+		}
+		for i, item := range iv {
+			if j := indexOf(iv, item, i+1); j >= 0 {
+				return falsy(fmt.Sprintf("\nValue have duplicated elements at indexes [%d] and [%d] : %v", i, j, item))
+			}
+		}
+		return truthy("\nValue should have duplicated elements")
+	}
+}
+
+// notFoundIn returns the indexes and elements of a that are not into b.
+func notFoundIn(a, b []interface{}) ([]int, []interface{}) {
+	indexes := make([]int, 0)
+	elements := make([]interface{}, 0)
+	for i, item := range a {
+		if indexOf(b, item, 0) < 0 {
+			indexes = append(indexes, i)
+			elements = append(elements, item)
+		}
+	}
+	return indexes, elements
+}
+
+func IsSubsetOf(e interface{}) Matcher {
+	return func(v interface{}) (MatchResult, error) {
+		iv, isSlice := toSlice(v)
+		if !isSlice {
+			return errored(ErrNotOfSliceType)
+		}
+		ie, isSlice := toSlice(e)
+		if !isSlice {
+			return errored(ErrNotOfSliceType)
+		}
+		if indexes, elements := notFoundIn(iv, ie); len(indexes) > 0 {
+			return falsy(fmt.Sprintf("\nValue is not a subset of : %v. Elements not found : %s",
+				e, formatElements(indexes, elements)))
+		}
+		return truthy(fmt.Sprintf("\nValue should not be a subset of : %v", e))
+	}
+}
+
+func IsSupersetOf(e interface{}) Matcher {
+	return func(v interface{}) (MatchResult, error) {
+		iv, isSlice := toSlice(v)
+		if !isSlice {
+			return errored(ErrNotOfSliceType)
+		}
+		ie, isSlice := toSlice(e)
+		if !isSlice {
+			return errored(ErrNotOfSliceType)
+		}
+		if indexes, elements := notFoundIn(ie, iv); len(indexes) > 0 {
+			return falsy(fmt.Sprintf("\nValue is not a superset of : %v. Missing elements : %s",
+				e, formatElements(indexes, elements)))
+		}
+		return truthy(fmt.Sprintf("\nValue should not be a superset of : %v", e))
+	}
+}
+
+func ContainsNone(e ...interface{}) Matcher {
+	return func(v interface{}) (MatchResult, error) {
+		iv, isSlice := toSlice(v)
+		if !isSlice {
+			return errored(ErrNotOfSliceType)
+		}
+		indexes := make([]int, 0)
+		elements := make([]interface{}, 0)
+		for i, item := range iv {
+			if indexOf(e, item, 0) >= 0 {
+				indexes = append(indexes, i)
+				elements = append(elements, item)
+			}
+		}
+		if len(indexes) > 0 {
+			return falsy(fmt.Sprintf("\nValue should not contain any of : %v. Found : %s",
+				e, formatElements(indexes, elements)))
+		}
+		return truthy(fmt.Sprintf("\nValue should contain at least one of : %v", e))
+	}
+}
@@ -0,0 +1,227 @@
+package assertion
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/elethoughts-code/goasserts/diff"
+)
+
+// SliceTransformer interface encloses slice related transformations.
+// All transformations return the same expectation interface to pile in calls (Fluent API).
+//
+// Extract(path ...interface{}) changes value to the slice of each element attribute found at path.
+// Path keys are resolved one after the other the same way Attr(key interface{}) does.
+//
+// Filter(m Matcher) changes value to the slice of elements matching m.
+//
+// MapValues(f func(v interface{}) interface{}) changes value to the slice of f results for each element.
+//
+// SortBy(path ...interface{}) changes value to a copy of the slice sorted by the attribute found at path.
+// An empty path sorts elements by their own value.
+//
+// Distinct() changes value to the slice without duplicated elements (first occurrences are kept).
+//
+// First() and Last() change value to the first or last element of the slice.
+//
+// Flatten() changes a slice of slices to the concatenation of its elements.
+//
+// GroupBy(path ...interface{}) changes value to a map of slices, keyed by the attribute found at path.
+type SliceTransformer interface {
+	Extract(path ...interface{}) Expectation
+	Filter(m Matcher) Expectation
+	MapValues(f func(v interface{}) interface{}) Expectation
+	SortBy(path ...interface{}) Expectation
+	Distinct() Expectation
+	First() Expectation
+	Last() Expectation
+	Flatten() Expectation
+	GroupBy(path ...interface{}) Expectation
+}
+
+func sliceOrPanic(v interface{}) []interface{} {
+	s, isSlice := toSlice(v)
+	if !isSlice {
+		panic("[type error] value should be a slice")
+	}
+	return s
+}
+
+func elemFromPath(v interface{}, path []interface{}) interface{} {
+	for _, key := range path {
+		v = elemFromKey(reflect.ValueOf(v), key)
+	}
+	return v
+}
+
+func (exp *expectation) Extract(path ...interface{}) Expectation {
+	if exp.v == nil {
+		return exp
+	}
+	s := sliceOrPanic(exp.v)
+	values := make([]interface{}, len(s))
+	for i, item := range s {
+		values[i] = elemFromPath(item, path)
+	}
+	exp.v = values
+	return exp
+}
+
+func (exp *expectation) Filter(m Matcher) Expectation {
+	if exp.v == nil {
+		return exp
+	}
+	s := sliceOrPanic(exp.v)
+	values := make([]interface{}, 0, len(s))
+	for _, item := range s {
+		mr, err := runMatcher(m, item)
+		if err != nil {
+			panic(err)
+		}
+		if mr.Matches {
+			values = append(values, item)
+		}
+	}
+	exp.v = values
+	return exp
+}
+
+func (exp *expectation) MapValues(f func(v interface{}) interface{}) Expectation {
+	if exp.v == nil {
+		return exp
+	}
+	s := sliceOrPanic(exp.v)
+	values := make([]interface{}, len(s))
+	for i, item := range s {
+		values[i] = f(item)
+	}
+	exp.v = values
+	return exp
+}
+
+func (exp *expectation) SortBy(path ...interface{}) Expectation {
+	if exp.v == nil {
+		return exp
+	}
+	s := sliceOrPanic(exp.v)
+	keys := make([]interface{}, len(s))
+	for i, item := range s {
+		keys[i] = elemFromPath(item, path)
+	}
+	indexes := make([]int, len(s))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	sort.SliceStable(indexes, func(i, j int) bool {
+		return compareBasics(keys[indexes[i]], keys[indexes[j]]) < 0
+	})
+	values := make([]interface{}, len(s))
+	for i, index := range indexes {
+		values[i] = s[index]
+	}
+	exp.v = values
+	return exp
+}
+
+func (exp *expectation) Distinct() Expectation {
+	if exp.v == nil {
+		return exp
+	}
+	s := sliceOrPanic(exp.v)
+	values := make([]interface{}, 0, len(s))
+	for _, item := range s {
+		found := false
+		for _, kept := range values {
+			if reflect.DeepEqual(item, kept) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			values = append(values, item)
+		}
+	}
+	exp.v = values
+	return exp
+}
+
+func (exp *expectation) First() Expectation {
+	if exp.v == nil {
+		return exp
+	}
+	s := sliceOrPanic(exp.v)
+	if len(s) == 0 {
+		panic("slice is empty")
+	}
+	exp.v = s[0]
+	return exp
+}
+
+func (exp *expectation) Last() Expectation {
+	if exp.v == nil {
+		return exp
+	}
+	s := sliceOrPanic(exp.v)
+	if len(s) == 0 {
+		panic("slice is empty")
+	}
+	exp.v = s[len(s)-1]
+	return exp
+}
+
+func (exp *expectation) Flatten() Expectation {
+	if exp.v == nil {
+		return exp
+	}
+	s := sliceOrPanic(exp.v)
+	values := make([]interface{}, 0, len(s))
+	for _, item := range s {
+		if is, isSlice := toSlice(item); isSlice {
+			values = append(values, is...)
+		} else {
+			values = append(values, item)
+		}
+	}
+	exp.v = values
+	return exp
+}
+
+func (exp *expectation) GroupBy(path ...interface{}) Expectation {
+	if exp.v == nil {
+		return exp
+	}
+	s := sliceOrPanic(exp.v)
+	groups := make(map[interface{}][]interface{})
+	for _, item := range s {
+		key := elemFromPath(item, path)
+		groups[key] = append(groups[key], item)
+	}
+	exp.v = groups
+	return exp
+}
+
+// compareBasics compares two basic kind values (numbers, strings and booleans) using diff.Compare.
+// It panics when values are not of the same basic kind family.
+func compareBasics(a, b interface{}) int {
+	ka, kb := reflect.ValueOf(a).Kind(), reflect.ValueOf(b).Kind()
+	switch {
+	case isNumericKind(ka) && isNumericKind(kb),
+		ka == reflect.String && kb == reflect.String,
+		ka == reflect.Bool && kb == reflect.Bool:
+		return diff.Compare(a, b)
+	default:
+		panic(fmt.Sprintf("[type error] values of type %T and %T cannot be ordered", a, b))
+	}
+}
+
+func isNumericKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
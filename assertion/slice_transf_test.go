@@ -0,0 +1,163 @@
+package assertion_test
+
+import (
+	"testing"
+
+	"github.com/elethoughts-code/goasserts/assertion"
+)
+
+type user struct {
+	Name    string
+	Age     int
+	Active  bool
+	Address struct {
+		City string
+	}
+}
+
+func newUser(name string, age int, active bool, city string) user {
+	u := user{Name: name, Age: age, Active: active}
+	u.Address.City = city
+	return u
+}
+
+var users = []user{
+	newUser("bob", 32, true, "Paris"),
+	newUser("alice", 27, false, "Lyon"),
+	newUser("carol", 45, true, "Paris"),
+}
+
+func isActive() assertion.Matcher {
+	return func(v interface{}) (assertion.MatchResult, error) {
+		return assertion.MatchResult{Matches: v.(user).Active}, nil
+	}
+}
+
+func Test_Extract_should_pass_assertions(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+
+	// When
+	assert.That(nil).Extract("Name").IsNil()
+	assert.That(users).Extract("Name").IsDeepEq([]interface{}{"bob", "alice", "carol"})
+	assert.That(users).Extract("Address", "City").IsDeepEq([]interface{}{"Paris", "Lyon", "Paris"})
+	assert.That([]map[string]int{{"a": 1}, {"a": 2}}).Extract("a").IsDeepEq([]interface{}{1, 2})
+	assert.That(users).Filter(isActive()).Extract("Name").Unordered([]interface{}{"carol", "bob"})
+
+	// Then nothing
+}
+
+func Test_Filter_should_pass_assertions(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+
+	// When
+	assert.That(nil).Filter(isActive()).IsNil()
+	assert.That(users).Filter(isActive()).HasLen(2)
+	assert.That([]string{"ab", "b", "ac"}).Filter(assertion.HasPrefix("a")).IsDeepEq([]interface{}{"ab", "ac"})
+	assert.That([]string{"ab", "b", "ac"}).Filter(assertion.IsEq("d")).IsEmpty()
+
+	// Then nothing
+}
+
+func Test_MapValues_should_pass_assertions(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+
+	// When
+	assert.That([]int{1, 2, 3}).MapValues(func(v interface{}) interface{} {
+		return v.(int) * 2
+	}).IsDeepEq([]interface{}{2, 4, 6})
+
+	// Then nothing
+}
+
+func Test_SortBy_should_pass_assertions(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+
+	// When
+	assert.That(users).SortBy("Age").Extract("Name").IsDeepEq([]interface{}{"alice", "bob", "carol"})
+	assert.That(users).SortBy("Name").First().Attr("Name").IsEq("alice")
+	assert.That(users).SortBy("Address", "City").Extract("Name").IsDeepEq([]interface{}{"alice", "bob", "carol"})
+	assert.That([]float64{3.5, 1, 2}).SortBy().IsDeepEq([]interface{}{1.0, 2.0, 3.5})
+	assert.That([]interface{}{3, uint(1), 2.5}).SortBy().IsDeepEq([]interface{}{uint(1), 2.5, 3})
+	assert.That([]bool{true, false}).SortBy().IsDeepEq([]interface{}{false, true})
+
+	// Then nothing
+}
+
+func Test_SortBy_panic_if_not_ordered(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+
+	// When / Then
+	defer func() {
+		r := recover()
+		assert.That(r).IsEq("[type error] values of type string and int cannot be ordered")
+	}()
+	assert.That([]interface{}{1, "a"}).SortBy()
+}
+
+func Test_Distinct_First_Last_should_pass_assertions(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+
+	// When
+	assert.That([]string{"a", "b", "a", "c", "b"}).Distinct().IsDeepEq([]interface{}{"a", "b", "c"})
+	assert.That([][]int{{1}, {2}, {1}}).Distinct().HasLen(2)
+	assert.That(users).Extract("Address", "City").Distinct().Unordered([]interface{}{"Lyon", "Paris"})
+	assert.That([]string{"a", "b", "c"}).First().IsEq("a")
+	assert.That([]string{"a", "b", "c"}).Last().IsEq("c")
+	assert.That(nil).First().IsNil()
+	assert.That(nil).Last().IsNil()
+
+	// Then nothing
+}
+
+func Test_First_panic_if_empty(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+
+	// When / Then
+	defer func() {
+		r := recover()
+		assert.That(r).IsEq("slice is empty")
+	}()
+	assert.That([]string{}).First()
+}
+
+func Test_Flatten_should_pass_assertions(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+
+	// When
+	assert.That([][]int{{1, 2}, {}, {3}}).Flatten().IsDeepEq([]interface{}{1, 2, 3})
+	assert.That([]interface{}{[]string{"a"}, "b"}).Flatten().IsDeepEq([]interface{}{"a", "b"})
+
+	// Then nothing
+}
+
+func Test_GroupBy_should_pass_assertions(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+
+	// When
+	assert.That(users).GroupBy("Address", "City").HasLen(2)
+	assert.That(users).GroupBy("Address", "City").Attr("Paris").Extract("Name").IsDeepEq([]interface{}{"bob", "carol"})
+	assert.That(users).GroupBy("Active").Attr(false).HasLen(1)
+
+	// Then nothing
+}
+
+func Test_Slice_transformers_panic_if_not_slice(t *testing.T) {
+	// Given
+	assert := assertion.New(t)
+
+	// When / Then
+	defer func() {
+		r := recover()
+		assert.That(r).IsEq("[type error] value should be a slice")
+	}()
+	assert.That("abc").Extract("Name")
+}
@@ -36,9 +36,14 @@ func (br stdBytesReader) ReadAll(r io.Reader) ([]byte, error) {
 	return ioutil.ReadAll(r)
 }
 
-var ErrNotOfResponseRecorderType = errors.New("value is not of type *httptest.ResponseRecorder")
+var ErrNotOfResponseRecorderType = errors.New("value is not of type *httptest.ResponseRecorder or *http.Response")
+var ErrNotOfRequestType = errors.New("value is not of type *http.Request")
+var ErrNotOfXMLType = errors.New("value should be a *XMLNode or an XML document")
 var ErrNotOfErrorType = errors.New("value is not of type error")
 var ErrNotOfLenType = errors.New("value type should be Array, Slice, String or Map")
 var ErrNotOfSliceType = errors.New("value should be a slice")
 var ErrNotOfMapType = errors.New("value should be a map")
 var ErrNotOfStringType = errors.New("value should be a string")
+var ErrNotOfFuncType = errors.New("value should be a func()")
+var ErrNoResults = errors.New("value should have at least one result")
+var ErrNotOfChanType = errors.New("value should be a receivable channel")
@@ -0,0 +1,338 @@
+package assertion
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+var errNoXMLRoot = errors.New("XML document has no root element")
+
+// XMLNode is a normalised XML element, used to compare XML documents structurally :
+// attributes order is ignored, namespaces prefixes are resolved to their URI, namespaces declarations
+// are dropped and text is trimmed (whitespace only text is ignored).
+//
+// Names are formatted as "{namespace-uri}local" or "local" when the element (or attribute) has no namespace.
+type XMLNode struct {
+	Name       string
+	Attributes map[string]string
+	Text       string
+	Children   []*XMLNode
+}
+
+func xmlName(n xml.Name) string {
+	if n.Space == "" {
+		return n.Local
+	}
+	return fmt.Sprintf("{%s}%s", n.Space, n.Local)
+}
+
+func localName(name string) string {
+	if i := strings.LastIndexByte(name, '}'); i >= 0 {
+		return name[i+1:]
+	}
+	return name
+}
+
+func parseXML(b []byte) (*XMLNode, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(b))
+	var root *XMLNode
+	stack := make([]*XMLNode, 0)
+	texts := make([][]string, 0)
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			node := &XMLNode{Name: xmlName(t.Name), Attributes: make(map[string]string)}
+			for _, attr := range t.Attr {
+				if attr.Name.Space == "xmlns" || attr.Name.Space == "" && attr.Name.Local == "xmlns" {
+					continue
+				}
+				node.Attributes[xmlName(attr.Name)] = attr.Value
+			}
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.Children = append(parent.Children, node)
+			} else {
+				root = node
+			}
+			stack = append(stack, node)
+			texts = append(texts, make([]string, 0))
+		case xml.EndElement:
+			stack[len(stack)-1].Text = strings.Join(texts[len(texts)-1], " ")
+			stack, texts = stack[:len(stack)-1], texts[:len(texts)-1]
+		case xml.CharData:
+			if text := strings.TrimSpace(string(t)); text != "" && len(texts) > 0 {
+				texts[len(texts)-1] = append(texts[len(texts)-1], text)
+			}
+		}
+	}
+	if root == nil {
+		return nil, errNoXMLRoot
+	}
+	return root, nil
+}
+
+// toXMLNode returns v if it is a *XMLNode or parses the XML document it holds (see rawBytes).
+func toXMLNode(v interface{}) (*XMLNode, error) {
+	if node, ok := v.(*XMLNode); ok {
+		return node, nil
+	}
+	b, ok, err := rawBytes(v)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNotOfXMLType
+	}
+	return parseXML(b)
+}
+
+type xpathStep struct {
+	descendant bool
+	test       string
+	predicates []string
+}
+
+func parseXPath(expr string) ([]xpathStep, bool, error) {
+	absolute := strings.HasPrefix(expr, "/")
+	steps := make([]xpathStep, 0)
+	descendant := false
+	current := strings.Builder{}
+	depth := 0
+	flush := func() error {
+		step := current.String()
+		current.Reset()
+		if step == "" {
+			if descendant {
+				return fmt.Errorf("invalid XPath expression %q", expr)
+			}
+			descendant = true
+			return nil
+		}
+		parsed, err := parseXPathStep(step, expr)
+		if err != nil {
+			return err
+		}
+		parsed.descendant = descendant
+		descendant = false
+		steps = append(steps, parsed)
+		return nil
+	}
+	for _, r := range strings.TrimPrefix(expr, "/") {
+		switch {
+		case r == '[':
+			depth++
+		case r == ']':
+			depth--
+		case r == '/' && depth == 0:
+			if err := flush(); err != nil {
+				return nil, false, err
+			}
+			continue
+		}
+		current.WriteRune(r)
+	}
+	if err := flush(); err != nil {
+		return nil, false, err
+	}
+	if descendant || depth != 0 || len(steps) == 0 {
+		return nil, false, fmt.Errorf("invalid XPath expression %q", expr)
+	}
+	return steps, absolute, nil
+}
+
+func parseXPathStep(step, expr string) (xpathStep, error) {
+	parsed := xpathStep{}
+	open := strings.IndexByte(step, '[')
+	if open < 0 {
+		parsed.test = step
+		return parsed, nil
+	}
+	parsed.test = step[:open]
+	for rest := step[open:]; rest != ""; {
+		end := strings.IndexByte(rest, ']')
+		if rest[0] != '[' || end < 0 {
+			return parsed, fmt.Errorf("invalid XPath expression %q", expr)
+		}
+		parsed.predicates = append(parsed.predicates, strings.TrimSpace(rest[1:end]))
+		rest = rest[end+1:]
+	}
+	return parsed, nil
+}
+
+// XPath evaluates a XPath subset on the node : absolute ("/a/b") and relative ("b/c") paths, descendants ("//c"),
+// wildcard ("*"), parent ("..") and self (".") steps, position ("[2]", "[last()]"), attribute ("[@id]",
+// "[@id='1']") and child text ("[name='bob']") predicates, ending with text() or @attribute to select values.
+// Element names are matched on their local name (namespace is ignored).
+func (n *XMLNode) XPath(expr string) ([]interface{}, error) {
+	steps, absolute, err := parseXPath(expr)
+	if err != nil {
+		return nil, err
+	}
+	document := &XMLNode{Children: []*XMLNode{n}}
+	parents := map[*XMLNode]*XMLNode{n: document}
+	indexParents(n, parents)
+	contexts := []*XMLNode{n}
+	if absolute {
+		contexts = []*XMLNode{document}
+	}
+	for i, step := range steps {
+		last := i == len(steps)-1
+		if step.test == "text()" || strings.HasPrefix(step.test, "@") {
+			if !last {
+				return nil, fmt.Errorf("invalid XPath expression %q : %s should be the last step", expr, step.test)
+			}
+			return selectValues(expandDescendants(contexts, step.descendant), step.test), nil
+		}
+		contexts, err = evalStep(expandDescendants(contexts, step.descendant), step, parents)
+		if err != nil {
+			return nil, fmt.Errorf("invalid XPath expression %q : %w", expr, err)
+		}
+	}
+	result := make([]interface{}, len(contexts))
+	for i, node := range contexts {
+		result[i] = node
+	}
+	return result, nil
+}
+
+func indexParents(n *XMLNode, parents map[*XMLNode]*XMLNode) {
+	for _, child := range n.Children {
+		parents[child] = n
+		indexParents(child, parents)
+	}
+}
+
+func expandDescendants(contexts []*XMLNode, descendant bool) []*XMLNode {
+	if !descendant {
+		return contexts
+	}
+	expanded := make([]*XMLNode, 0)
+	var walk func(n *XMLNode)
+	walk = func(n *XMLNode) {
+		expanded = append(expanded, n)
+		for _, child := range n.Children {
+			walk(child)
+		}
+	}
+	for _, n := range contexts {
+		walk(n)
+	}
+	return uniqueNodes(expanded)
+}
+
+func uniqueNodes(nodes []*XMLNode) []*XMLNode {
+	seen := make(map[*XMLNode]bool, len(nodes))
+	unique := make([]*XMLNode, 0, len(nodes))
+	for _, n := range nodes {
+		if !seen[n] {
+			seen[n] = true
+			unique = append(unique, n)
+		}
+	}
+	return unique
+}
+
+func selectValues(contexts []*XMLNode, test string) []interface{} {
+	values := make([]interface{}, 0)
+	for _, n := range contexts {
+		if test == "text()" {
+			if n.Text != "" {
+				values = append(values, n.Text)
+			}
+			continue
+		}
+		for name, value := range n.Attributes {
+			if localName(name) == test[1:] || name == test[1:] {
+				values = append(values, value)
+			}
+		}
+	}
+	return values
+}
+
+func evalStep(contexts []*XMLNode, step xpathStep, parents map[*XMLNode]*XMLNode) ([]*XMLNode, error) {
+	result := make([]*XMLNode, 0)
+	for _, context := range contexts {
+		candidates := make([]*XMLNode, 0)
+		switch step.test {
+		case ".":
+			candidates = append(candidates, context)
+		case "..":
+			if parent, ok := parents[context]; ok {
+				candidates = append(candidates, parent)
+			}
+		default:
+			for _, child := range context.Children {
+				if step.test == "*" || localName(child.Name) == step.test || child.Name == step.test {
+					candidates = append(candidates, child)
+				}
+			}
+		}
+		for _, predicate := range step.predicates {
+			filtered, err := filterNodes(candidates, predicate)
+			if err != nil {
+				return nil, err
+			}
+			candidates = filtered
+		}
+		result = append(result, candidates...)
+	}
+	return uniqueNodes(result), nil
+}
+
+func filterNodes(nodes []*XMLNode, predicate string) ([]*XMLNode, error) {
+	if predicate == "last()" {
+		if len(nodes) == 0 {
+			return nodes, nil
+		}
+		return nodes[len(nodes)-1:], nil
+	}
+	if position, err := strconv.Atoi(predicate); err == nil {
+		if position < 1 || position > len(nodes) {
+			return []*XMLNode{}, nil
+		}
+		return nodes[position-1 : position], nil
+	}
+	name, value, withValue := predicate, "", false
+	if i := strings.IndexByte(predicate, '='); i >= 0 {
+		name, value, withValue = strings.TrimSpace(predicate[:i]), strings.TrimSpace(predicate[i+1:]), true
+		if len(value) < 2 || value[0] != value[len(value)-1] || value[0] != '\'' && value[0] != '"' {
+			return nil, fmt.Errorf("unsupported predicate [%s]", predicate)
+		}
+		value = value[1 : len(value)-1]
+	}
+	filtered := make([]*XMLNode, 0)
+	for _, n := range nodes {
+		var values []interface{}
+		if strings.HasPrefix(name, "@") {
+			values = selectValues([]*XMLNode{n}, name)
+		} else {
+			children, err := evalStep([]*XMLNode{n}, xpathStep{test: name}, nil)
+			if err != nil {
+				return nil, err
+			}
+			for _, child := range children {
+				values = append(values, child.Text)
+			}
+		}
+		for _, v := range values {
+			if !withValue || v == value {
+				filtered = append(filtered, n)
+				break
+			}
+		}
+	}
+	return filtered, nil
+}
@@ -0,0 +1,155 @@
+package diff
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"sort"
+)
+
+const (
+	nilRank int = iota
+	boolRank
+	numericRank
+	stringRank
+	otherRank
+)
+
+func kindRank(v reflect.Value) int {
+	switch v.Kind() {
+	case reflect.Invalid:
+		return nilRank
+	case reflect.Bool:
+		return boolRank
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return numericRank
+	case reflect.String:
+		return stringRank
+	default:
+		return otherRank
+	}
+}
+
+func compareOrdered(lower, greater bool) int {
+	switch {
+	case lower:
+		return -1
+	case greater:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func compareNumeric(va, vb reflect.Value) int {
+	ia, aIsInt := asInt(va)
+	ib, bIsInt := asInt(vb)
+	ua, aIsUint := asUint(va)
+	ub, bIsUint := asUint(vb)
+	switch {
+	case aIsInt && bIsInt:
+		return compareOrdered(ia < ib, ia > ib)
+	case aIsUint && bIsUint:
+		return compareOrdered(ua < ub, ua > ub)
+	case aIsInt && bIsUint:
+		return compareOrdered(ia < 0 || uint64(ia) < ub, ia >= 0 && uint64(ia) > ub)
+	case aIsUint && bIsInt:
+		return compareOrdered(ib >= 0 && ua < uint64(ib), ib < 0 || ua > uint64(ib))
+	}
+	// At least one float : NaN is lower than any other number, others are compared exactly
+	aIsNaN := isFloat(va) && math.IsNaN(va.Float())
+	bIsNaN := isFloat(vb) && math.IsNaN(vb.Float())
+	if aIsNaN || bIsNaN {
+		return compareOrdered(!bIsNaN, !aIsNaN)
+	}
+	return asBigFloat(va).Cmp(asBigFloat(vb))
+}
+
+func isFloat(v reflect.Value) bool {
+	return v.Kind() == reflect.Float32 || v.Kind() == reflect.Float64
+}
+
+// asBigFloat converts a non NaN numeric value without loss of precision.
+func asBigFloat(v reflect.Value) *big.Float {
+	if i, ok := asInt(v); ok {
+		return new(big.Float).SetInt64(i)
+	}
+	if u, ok := asUint(v); ok {
+		return new(big.Float).SetUint64(u)
+	}
+	return big.NewFloat(v.Float())
+}
+
+func asInt(v reflect.Value) (int64, bool) {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int(), true
+	default:
+		return 0, false
+	}
+}
+
+func asUint(v reflect.Value) (uint64, bool) {
+	switch v.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint(), true
+	default:
+		return 0, false
+	}
+}
+
+// Compare orders values. It returns a negative number when a < b,
+// zero when a and b are equivalent and a positive number when a > b.
+//
+// Values are first ordered by kind : nil < booleans < numbers < strings < other kinds.
+// Numbers are compared exactly by value whatever their kind (int, uint or float), NaN being lower than
+// any other number and equivalent to itself. Strings are compared lexicographically and false < true.
+// Other kinds (pointers, structs, slices, maps...) have no defined order : they are grouped by type name
+// then compared by their "%v" representation, which only keeps sorting deterministic
+// (pointers for instance are compared by address).
+func Compare(a, b interface{}) int {
+	return compareValues(reflect.ValueOf(a), reflect.ValueOf(b))
+}
+
+func compareValues(va, vb reflect.Value) int {
+	va, vb = interfaceDereference(va), interfaceDereference(vb)
+	if va.Kind() == reflect.Interface {
+		va = reflect.Value{}
+	}
+	if vb.Kind() == reflect.Interface {
+		vb = reflect.Value{}
+	}
+	ra, rb := kindRank(va), kindRank(vb)
+	if ra != rb {
+		return compareOrdered(ra < rb, ra > rb)
+	}
+	switch ra {
+	case boolRank:
+		return compareOrdered(!va.Bool() && vb.Bool(), va.Bool() && !vb.Bool())
+	case numericRank:
+		return compareNumeric(va, vb)
+	case stringRank:
+		return compareOrdered(va.String() < vb.String(), va.String() > vb.String())
+	case otherRank:
+		ta, tb := va.Type().String(), vb.Type().String()
+		if ta != tb {
+			return compareOrdered(ta < tb, ta > tb)
+		}
+		sa, sb := fmt.Sprintf("%v", va), fmt.Sprintf("%v", vb)
+		return compareOrdered(sa < sb, sa > sb)
+	default:
+		return 0
+	}
+}
+
+// sortedMapKeys returns the m map keys sorted using Compare so diffs are reported in a stable order.
+func sortedMapKeys(m reflect.Value) []reflect.Value {
+	keys := m.MapKeys()
+	sort.SliceStable(keys, func(i, j int) bool {
+		return compareValues(keys[i], keys[j]) < 0
+	})
+	return keys
+}
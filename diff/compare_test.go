@@ -0,0 +1,81 @@
+package diff_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/elethoughts-code/goasserts/diff"
+)
+
+func Test_Compare_total_ordering(t *testing.T) {
+	// Given
+	type S struct{ A int }
+	testCases := []struct {
+		a      interface{}
+		b      interface{}
+		result int
+	}{
+		{a: nil, b: false, result: -1},
+		{a: false, b: true, result: -1},
+		{a: true, b: 0, result: -1},
+		{a: 1, b: uint8(2), result: -1},
+		{a: 2.5, b: 2, result: 1},
+		{a: int64(-1), b: uint(0), result: -1},
+		{a: 3, b: 3.0, result: 0},
+		{a: 100, b: "1", result: -1},
+		{a: "a", b: "b", result: -1},
+		{a: "b", b: S{1}, result: -1},
+		{a: S{1}, b: S{2}, result: -1},
+		{a: S{2}, b: S{2}, result: 0},
+		{a: math.NaN(), b: math.Inf(-1), result: -1},
+		{a: math.NaN(), b: float32(math.NaN()), result: 0},
+		{a: math.NaN(), b: math.MinInt64, result: -1},
+		{a: int64(math.MaxInt64), b: uint64(math.MaxInt64) + 1, result: -1},
+		{a: int64(-1), b: uint64(math.MaxUint64), result: -1},
+		{a: uint64(math.MaxUint64), b: uint64(math.MaxUint64) - 1, result: 1},
+		{a: int64(1 << 53), b: int64(1<<53) + 1, result: -1},
+		{a: float64(1 << 53), b: int64(1<<53) + 1, result: -1},
+		{a: math.Inf(1), b: uint64(math.MaxUint64), result: 1},
+	}
+
+	for _, tc := range testCases {
+		// When
+		r := diff.Compare(tc.a, tc.b)
+		rr := diff.Compare(tc.b, tc.a)
+		// Then
+		if r != tc.result || rr != -tc.result {
+			t.Errorf("Compare(%v, %v) = %d, %d", tc.a, tc.b, r, rr)
+		}
+	}
+}
+
+func Test_map_diffs_are_reported_in_keys_order(t *testing.T) {
+	// Given
+	a := map[string]int{"e": 1, "d": 1, "c": 1, "b": 1, "a": 1}
+	b := map[string]int{"e": 2, "d": 2, "c": 2, "b": 2, "a": 2}
+	expectedPaths := [][]string{{"[a]"}, {"[b]"}, {"[c]"}, {"[d]"}, {"[e]"}}
+
+	for i := 0; i < 10; i++ {
+		// When
+		diffs := diff.Diffs(a, b)
+		similarDiffs := diff.Similar(a, b, false)
+		nonStringKeyDiffs := diff.Similar(map[int]int{3: 1, 1: 1, 2: 1}, map[int]int{3: 2, 1: 2, 2: 2}, false)
+
+		// Then
+		paths := make([][]string, 0)
+		similarPaths := make([][]string, 0)
+		nonStringKeyPaths := make([][]string, 0)
+		for j := range diffs {
+			paths = append(paths, diffs[j].Path)
+			similarPaths = append(similarPaths, similarDiffs[j].Path)
+		}
+		for _, d := range nonStringKeyDiffs {
+			nonStringKeyPaths = append(nonStringKeyPaths, d.Path)
+		}
+		if !reflect.DeepEqual(paths, expectedPaths) || !reflect.DeepEqual(similarPaths, expectedPaths) ||
+			!reflect.DeepEqual(nonStringKeyPaths, [][]string{{"[1]"}, {"[2]"}, {"[3]"}}) {
+			t.Fatalf("unexpected paths order %v %v %v", paths, similarPaths, nonStringKeyPaths)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package diff
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// DiffOption configures a DiffsWith comparison.
+type DiffOption func(ctx *diffContext)
+
+// MaxDiffs option only returns the n first differences, the following ones being only counted (see Stats).
+func MaxDiffs(n int) DiffOption {
+	return func(ctx *diffContext) {
+		ctx.maxDiffs = n
+	}
+}
+
+// MaxDepth option compares values found d path steps deep as a whole : a single CommonDiff is reported
+// for such values having any difference, Stats.Truncated being set when it hides more than one difference.
+func MaxDepth(d int) DiffOption {
+	return func(ctx *diffContext) {
+		ctx.maxDepth = d
+	}
+}
+
+// StopAtFirst option stops the comparison at the first difference found.
+func StopAtFirst() DiffOption {
+	return func(ctx *diffContext) {
+		ctx.stopAtFirst = true
+	}
+}
+
+// Stats summarizes a DiffsWith comparison.
+//
+// Compared is the number of compared values, Differences the number of found differences (including the ones
+// over MaxDiffs) and Truncated reports that the returned differences are not exhaustive.
+type Stats struct {
+	Compared    int
+	Differences int
+	Truncated   bool
+}
+
+// Summary describes the shown differences among all the found ones (eg. "showing 10 of 4,231 differences").
+func (s Stats) Summary(shown int) string {
+	if s.Differences > shown {
+		return fmt.Sprintf("showing %s of %s", formatCount(shown), differences(s.Differences))
+	}
+	if s.Truncated {
+		return fmt.Sprintf("showing %s, comparison was truncated", differences(shown))
+	}
+	return fmt.Sprintf("showing %s", differences(shown))
+}
+
+func differences(n int) string {
+	if n == 1 || n == -1 {
+		return formatCount(n) + " difference"
+	}
+	return formatCount(n) + " differences"
+}
+
+func formatCount(n int) string {
+	if n < 0 {
+		return "-" + formatCount(-n)
+	}
+	digits := strconv.Itoa(n)
+	for i := len(digits) - 3; i > 0; i -= 3 {
+		digits = digits[:i] + "," + digits[i:]
+	}
+	return digits
+}
+
+type diffContext struct {
+	visited     map[visit]bool
+	maxDiffs    int
+	maxDepth    int
+	stopAtFirst bool
+	diffs       []Diff
+	stats       Stats
+}
+
+func newDiffContext(opts []DiffOption) *diffContext {
+	ctx := &diffContext{visited: make(map[visit]bool), diffs: make([]Diff, 0)}
+	for _, opt := range opts {
+		opt(ctx)
+	}
+	return ctx
+}
+
+func (ctx *diffContext) add(d Diff) {
+	if ctx.stopped() {
+		ctx.stats.Truncated = true
+		return
+	}
+	ctx.stats.Differences++
+	if ctx.maxDiffs > 0 && len(ctx.diffs) >= ctx.maxDiffs {
+		ctx.stats.Truncated = true
+		return
+	}
+	ctx.diffs = append(ctx.diffs, d)
+}
+
+func (ctx *diffContext) eq(a, b interface{}, currentPath Path) {
+	if a != b {
+		ctx.add(newDiff(currentPath, CommonDiff{a, b}))
+	}
+}
+
+// detailed reports whether further differences are worth detailing (see StopAtFirst and MaxDiffs).
+func (ctx *diffContext) detailed() bool {
+	return !ctx.stopAtFirst && (ctx.maxDiffs <= 0 || len(ctx.diffs) < ctx.maxDiffs)
+}
+
+// interrupted reports whether the comparison is stopped, remaining comparisons being truncated.
+func (ctx *diffContext) interrupted() bool {
+	if ctx.stopped() {
+		ctx.stats.Truncated = true
+		return true
+	}
+	return false
+}
+
+func (ctx *diffContext) stopped() bool {
+	return ctx.stopAtFirst && ctx.stats.Differences > 0
+}
+
+func (ctx *diffContext) tooDeep(currentPath Path, k reflect.Kind) bool {
+	if ctx.maxDepth <= 0 || len(currentPath) < ctx.maxDepth {
+		return false
+	}
+	switch k {
+	case reflect.Array, reflect.Slice, reflect.Interface, reflect.Ptr, reflect.Struct, reflect.Map:
+		return true
+	default:
+		return false
+	}
+}
+
+// checkSummarized compares va and vb, their differences being reported as a single CommonDiff.
+// The comparison is truncated only when more than one difference is summarized.
+func checkSummarized(currentPath Path, va, vb reflect.Value, ctx *diffContext) {
+	sub := &diffContext{visited: make(map[visit]bool), maxDiffs: 1, diffs: make([]Diff, 0)}
+	findDiffs(currentPath, va, vb, sub)
+	// va and vb comparison is already counted
+	ctx.stats.Compared += sub.stats.Compared - 1
+	if sub.stats.Differences > 1 {
+		ctx.stats.Truncated = true
+	}
+	if len(sub.diffs) > 0 {
+		ctx.add(newDiff(currentPath, CommonDiff{interfaceOf(va), interfaceOf(vb)}))
+	}
+}
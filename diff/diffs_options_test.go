@@ -0,0 +1,126 @@
+package diff_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/elethoughts-code/goasserts/diff"
+)
+
+func largeSlices(n, differences int) ([]int, []int) {
+	a, b := make([]int, n), make([]int, n)
+	for i := range a {
+		a[i], b[i] = i, i
+	}
+	for i := 0; i < differences; i++ {
+		b[i*(n/differences)]++
+	}
+	return a, b
+}
+
+func Test_DiffsWith_max_diffs(t *testing.T) {
+	// Given
+	a, b := largeSlices(50000, 4231)
+
+	// When
+	result, stats := diff.DiffsWith(a, b, diff.MaxDiffs(10))
+
+	// Then
+	if len(result) != 10 || !reflect.DeepEqual(withoutSteps(result[:1]), diffs(d(path("[0]"), diff.CommonDiff{A: int64(0),
+		B: int64(1)}))) {
+		t.Errorf("unexpected diffs %v", result)
+	}
+	if stats != (diff.Stats{Compared: 50001, Differences: 4231, Truncated: true}) {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+	if stats.Summary(len(result)) != "showing 10 of 4,231 differences" {
+		t.Errorf("unexpected summary %s", stats.Summary(len(result)))
+	}
+}
+
+func Test_DiffsWith_stop_at_first(t *testing.T) {
+	// Given
+	a, b := largeSlices(50000, 4231)
+
+	// When
+	result, stats := diff.DiffsWith(a, b, diff.StopAtFirst())
+	equalResult, equalStats := diff.DiffsWith(a, a, diff.StopAtFirst())
+
+	// Then
+	if len(result) != 1 || stats != (diff.Stats{Compared: 2, Differences: 1, Truncated: true}) {
+		t.Errorf("unexpected diffs %v %+v", result, stats)
+	}
+	if stats.Summary(len(result)) != "showing 1 difference, comparison was truncated" {
+		t.Errorf("unexpected summary %s", stats.Summary(len(result)))
+	}
+	if len(equalResult) != 0 || equalStats.Truncated {
+		t.Errorf("unexpected diffs %v %+v", equalResult, equalStats)
+	}
+}
+
+func Test_DiffsWith_max_depth(t *testing.T) {
+	// Given
+	type leaf struct {
+		Values []int
+	}
+	type node struct {
+		Name  string
+		Leafs []leaf
+	}
+	a := node{Name: "a", Leafs: []leaf{{Values: []int{1, 2}}, {Values: []int{3}}}}
+	b := node{Name: "b", Leafs: []leaf{{Values: []int{1, 3}}, {Values: []int{3}}}}
+
+	// When
+	result, stats := diff.DiffsWith(a, b, diff.MaxDepth(2))
+	full, fullStats := diff.DiffsWith(a, b)
+	c := node{Name: "c", Leafs: []leaf{{Values: []int{2, 3}}, {Values: []int{3}}}}
+	summarized, summarizedStats := diff.DiffsWith(a, c, diff.MaxDepth(2))
+
+	// Then
+	expected := diffs(
+		d(path("[Name]"), diff.CommonDiff{A: "a", B: "b"}),
+		d(path("[Leafs]", "[0]"), diff.CommonDiff{A: a.Leafs[0], B: b.Leafs[0]}),
+	)
+	if !reflect.DeepEqual(withoutSteps(result), expected) || stats.Differences != 2 || stats.Truncated {
+		t.Errorf("unexpected diffs %v %+v", result, stats)
+	}
+	if len(summarized) != 2 || summarizedStats.Differences != 2 || !summarizedStats.Truncated ||
+		summarizedStats.Summary(len(summarized)) != "showing 2 differences, comparison was truncated" {
+		t.Errorf("unexpected diffs %v %+v", summarized, summarizedStats)
+	}
+	if len(full) != 2 || !reflect.DeepEqual(full[1].Path, path("[Leafs]", "[0]", "[Values]", "[1]")) ||
+		fullStats.Truncated || fullStats.Compared != stats.Compared {
+		t.Errorf("unexpected diffs %v %+v %+v", full, fullStats, stats)
+	}
+}
+
+func Test_Diffs_should_not_be_truncated_by_default(t *testing.T) {
+	// Given
+	a, b := largeSlices(1000, 100)
+
+	// When
+	result, stats := diff.DiffsWith(a, b)
+
+	// Then
+	if len(result) != 100 || stats != (diff.Stats{Compared: 1001, Differences: 100}) || len(diff.Diffs(a, b)) != 100 {
+		t.Errorf("unexpected diffs %d %+v", len(result), stats)
+	}
+}
+
+func Test_Equal(t *testing.T) {
+	// Given
+	a, b := largeSlices(1000, 10)
+
+	// Then
+	if !diff.Equal(a, a) || diff.Equal(a, b) || !diff.Equal(nil, nil) || diff.Equal(nil, a) {
+		t.Error("unexpected equality")
+	}
+	if !diff.Equal(map[string][]int{"a": {1}}, map[string][]int{"a": {1}}) {
+		t.Error("maps should be equal")
+	}
+	opts := make([]diff.DiffOption, 1, 2)
+	opts[0] = diff.MaxDepth(1)
+	if diff.Equal(a, b, opts...) || opts[:2][1] != nil {
+		t.Error("caller options were modified")
+	}
+}
@@ -0,0 +1,140 @@
+package diff
+
+// editScriptMaxCost caps the Myers algorithm trace size (about (n+m)*d, d being the edit distance) and
+// editScriptMaxComparisons the number of values compared by the element comparisons. Over them, indexed values
+// of different lengths are reported as a single LenDiff.
+const (
+	editScriptMaxCost        = 1 << 20
+	editScriptMaxComparisons = 1 << 18
+)
+
+type editKind int
+
+const (
+	editEqual editKind = iota
+	editDelete
+	editInsert
+)
+
+// editOp is an edit script operation : A element at aIndex is kept (as B element at bIndex), deleted or
+// B element at bIndex is inserted.
+type editOp struct {
+	kind   editKind
+	aIndex int
+	bIndex int
+}
+
+// editScript computes the shortest edit script turning A (n elements) into B (m elements) with the Myers
+// algorithm, elements being compared by eq which also returns the number of compared values.
+// ok is false as soon as the script cost exceeds editScriptMaxCost or editScriptMaxComparisons.
+func editScript(n, m int, eq func(i, j int) (equal bool, cost int)) (ops []editOp, ok bool) {
+	cost := 0
+	results := make(map[[2]int]bool)
+	// elements may be compared several times
+	equal := func(i, j int) bool {
+		key := [2]int{i, j}
+		if r, found := results[key]; found {
+			return r
+		}
+		r, c := eq(i, j)
+		cost += c
+		results[key] = r
+		return r
+	}
+	total := n + m
+	offset := total + 1
+	v := make([]int, 2*total+3)
+	trace := make([][]int, 0)
+	for d := 0; d <= total; d++ {
+		if d*total > editScriptMaxCost {
+			return nil, false
+		}
+		trace = append(trace, append([]int(nil), v...))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && cost <= editScriptMaxComparisons && equal(x, y) {
+				x++
+				y++
+			}
+			if cost > editScriptMaxComparisons {
+				return nil, false
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				return backtrackEditScript(trace, offset, n, m), true
+			}
+		}
+	}
+	return nil, false
+}
+
+func backtrackEditScript(trace [][]int, offset, n, m int) []editOp {
+	ops := make([]editOp, 0, n+m)
+	x, y := n, m
+	for d := len(trace) - 1; d >= 0; d-- {
+		v := trace[d]
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := v[offset+prevK]
+		prevY := prevX - prevK
+		for x > prevX && y > prevY {
+			x--
+			y--
+			ops = append(ops, editOp{editEqual, x, y})
+		}
+		if d > 0 {
+			if x == prevX {
+				ops = append(ops, editOp{editInsert, x, prevY})
+			} else {
+				ops = append(ops, editOp{editDelete, prevX, y})
+			}
+		}
+		x, y = prevX, prevY
+	}
+	for i, j := 0, len(ops)-1; i < j; i, j = i+1, j-1 {
+		ops[i], ops[j] = ops[j], ops[i]
+	}
+	return ops
+}
+
+// editHunks walks an edit script : within each run of deletions and insertions, the first deleted and inserted
+// elements are paired as modified elements, the others being reported as deleted or inserted.
+func editHunks(ops []editOp, equal, modified func(i, j int), deleted, inserted func(i int)) {
+	deletions, insertions := make([]int, 0), make([]int, 0)
+	flush := func() {
+		for p := 0; p < len(deletions) || p < len(insertions); p++ {
+			switch {
+			case p < len(deletions) && p < len(insertions):
+				modified(deletions[p], insertions[p])
+			case p < len(deletions):
+				deleted(deletions[p])
+			default:
+				inserted(insertions[p])
+			}
+		}
+		deletions, insertions = deletions[:0], insertions[:0]
+	}
+	for _, op := range ops {
+		switch op.kind {
+		case editDelete:
+			deletions = append(deletions, op.aIndex)
+		case editInsert:
+			insertions = append(insertions, op.bIndex)
+		default:
+			flush()
+			equal(op.aIndex, op.bIndex)
+		}
+	}
+	flush()
+}
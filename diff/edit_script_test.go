@@ -0,0 +1,182 @@
+package diff_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/elethoughts-code/goasserts/diff"
+)
+
+func Test_Diffs_slices_edit_script(t *testing.T) {
+	// Given
+	type item struct {
+		ID   int
+		Name string
+	}
+	testCases := []struct {
+		a      interface{}
+		b      interface{}
+		result []diff.Diff
+	}{
+		{
+			a:      []string{"a", "b", "c", "d"},
+			b:      []string{"a", "c", "d"},
+			result: diffs(d(path("[1]"), diff.DeletedDiff{Value: "b"})),
+		},
+		{
+			a: []string{"a", "d"},
+			b: []string{"x", "a", "b", "c", "d"},
+			result: diffs(
+				d(path("[0]"), diff.InsertedDiff{Value: "x"}),
+				d(path("[2]"), diff.InsertedDiff{Value: "b"}),
+				d(path("[3]"), diff.InsertedDiff{Value: "c"}),
+			),
+		},
+		{
+			a: []item{{1, "a"}, {2, "b"}, {3, "c"}},
+			b: []item{{1, "a"}, {2, "B"}},
+			result: diffs(
+				d(path("[1]", "[Name]"), diff.CommonDiff{A: "b", B: "B"}),
+				d(path("[2]"), diff.DeletedDiff{Value: item{3, "c"}}),
+			),
+		},
+		{
+			a:      []int(nil),
+			b:      []int{1},
+			result: diffs(d([]string{}, diff.CommonDiff{A: nil, B: []int{1}})),
+		},
+	}
+
+	for i, tc := range testCases {
+		// When
+		result := diff.Diffs(tc.a, tc.b)
+		// Then
+		if !reflect.DeepEqual(withoutSteps(result), tc.result) {
+			t.Errorf("%d : unexpected diffs %v", i, result)
+		}
+	}
+}
+
+func Test_Similar_slices_edit_script(t *testing.T) {
+	// Given
+	var captured string
+	testCases := []struct {
+		a      interface{}
+		b      interface{}
+		result []diff.Diff
+	}{
+		{
+			a:      []int{1, 2, 3, 4},
+			b:      []float64{1, 3, 4},
+			result: diffs(d(path("[1]"), diff.DeletedDiff{Value: 2})),
+		},
+		{
+			a: []map[string]interface{}{{"id": 1, "tag": "a"}, {"id": 3, "tag": "c"}},
+			b: []interface{}{
+				map[string]interface{}{"id": 1, "tag": diff.Any()},
+				map[string]interface{}{"id": 2, "tag": "b"},
+				map[string]interface{}{"id": 3, "tag": diff.Capture(&captured)},
+			},
+			result: diffs(d(path("[1]"), diff.InsertedDiff{Value: map[string]interface{}{"id": 2, "tag": "b"}})),
+		},
+		{
+			a: []string{"a", "b", "c"},
+			b: []string{"a", "x"},
+			result: diffs(
+				d(path("[1]"), diff.CommonDiff{A: "b", B: "x"}),
+				d(path("[2]"), diff.DeletedDiff{Value: "c"}),
+			),
+		},
+		{
+			a:      []string(nil),
+			b:      []string{"a"},
+			result: diffs(d(path("[0]"), diff.InsertedDiff{Value: "a"})),
+		},
+	}
+
+	for i, tc := range testCases {
+		// When
+		result := diff.Similar(tc.a, tc.b, false)
+		// Then
+		if !reflect.DeepEqual(withoutSteps(result), tc.result) {
+			t.Errorf("%d : unexpected diffs %v", i, result)
+		}
+	}
+	if captured != "c" {
+		t.Errorf("unexpected capture %s", captured)
+	}
+}
+
+func Test_slices_edit_script_complexity_cap(t *testing.T) {
+	// Given
+	a, b := make([]int, 5000), make([]int, 4999)
+	for i := range a {
+		a[i] = i
+	}
+	for i := range b {
+		b[i] = -i - 1
+	}
+
+	// When
+	result := diff.Diffs(a, b)
+	similarResult := diff.Similar(a, b, false)
+
+	// Then
+	expected := diffs(d([]string{}, diff.LenDiff{CommonDiff: diff.CommonDiff{A: a, B: b}, Value: 1}))
+	if !reflect.DeepEqual(withoutSteps(result), expected) || !reflect.DeepEqual(withoutSteps(similarResult), expected) {
+		t.Errorf("unexpected diffs %v %v", len(result), len(similarResult))
+	}
+}
+
+func Test_slices_edit_script_comparisons_cap(t *testing.T) {
+	// Given
+	a, b := make([][]int, 1000), make([][]int, 999)
+	for i := range a {
+		a[i] = make([]int, 100)
+		for j := range a[i] {
+			a[i][j] = i*1000 + j
+		}
+	}
+	for i := range b {
+		b[i] = make([]int, 100)
+		for j := range b[i] {
+			b[i][j] = -i*1000 - j - 1
+		}
+	}
+
+	// When
+	result, stats := diff.DiffsWith(a, b)
+	similarResult := diff.Similar(a, b, false)
+
+	// Then
+	expected := diffs(d([]string{}, diff.LenDiff{CommonDiff: diff.CommonDiff{A: a, B: b}, Value: 1}))
+	if !reflect.DeepEqual(withoutSteps(result), expected) || !reflect.DeepEqual(withoutSteps(similarResult), expected) {
+		t.Errorf("unexpected diffs %v %v", len(result), len(similarResult))
+	}
+	if stats.Compared < 1<<18 {
+		t.Errorf("edit script comparisons should be counted %+v", stats)
+	}
+}
+
+func Test_slices_edit_script_should_be_skipped_when_not_detailed(t *testing.T) {
+	// Given
+	a, b := []int{1, 2, 3, 4}, []int{1, 3, 4}
+	lenDiff := diffs(d([]string{}, diff.LenDiff{CommonDiff: diff.CommonDiff{A: a, B: b}, Value: 1}))
+
+	// When
+	first, firstStats := diff.DiffsWith(a, b, diff.StopAtFirst())
+	full, fullStats := diff.DiffsWith(a, b)
+	limited, _ := diff.DiffsWith(map[string][]int{"a": {0}, "b": a}, map[string][]int{"a": {1}, "b": b}, diff.MaxDiffs(1))
+
+	// Then
+	if !reflect.DeepEqual(withoutSteps(first), lenDiff) || firstStats.Compared != 1 {
+		t.Errorf("unexpected diffs %v %+v", first, firstStats)
+	}
+	deleted := diffs(d(path("[1]"), diff.DeletedDiff{Value: 2}))
+	if !reflect.DeepEqual(withoutSteps(full), deleted) || fullStats.Compared <= 1 {
+		t.Errorf("unexpected diffs %v %+v", full, fullStats)
+	}
+	if len(limited) != 1 || !reflect.DeepEqual(limited[0].Path, path("[a]", "[0]")) {
+		t.Errorf("unexpected diffs %v", limited)
+	}
+}
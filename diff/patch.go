@@ -0,0 +1,491 @@
+package diff
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidPatch is returned by Apply when an operation cannot be applied.
+var ErrInvalidPatch = errors.New("invalid patch")
+
+// RFC 6902 JSON Patch operations produced by Patch.
+const (
+	PatchAdd     = "add"
+	PatchRemove  = "remove"
+	PatchReplace = "replace"
+)
+
+// PatchOperation is a RFC 6902 JSON Patch operation on the value located by Path.
+// Pointer is Path rendered as a JSON Pointer, struct fields being named by their json tag.
+// Value is the added or replacing value (nil for removals).
+type PatchOperation struct {
+	Op      string
+	Path    Path
+	Pointer string
+	Value   interface{}
+}
+
+// Patches are the changes turning a value into another one (see Patch).
+type Patches struct {
+	Operations []PatchOperation
+	a, b       interface{}
+}
+
+// Patch function returns the changes turning a into b, computed from Diffs(a, b).
+//
+// Operations are meant to be applied in order (see Apply) : removals come first (from the last slice elements),
+// then additions and finally replacements. Paths of elements kept in slices having insertions or deletions
+// are translated to their B index.
+func Patch(a, b interface{}) Patches {
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	diffs := Diffs(a, b)
+	edits := sliceEditsOf(diffs)
+	removals, additions, replacements := make([]PatchOperation, 0), make([]PatchOperation, 0), make([]PatchOperation, 0)
+	for _, d := range diffs {
+		switch dv := d.Value.(type) {
+		case DeletedDiff:
+			removals = append(removals, patchOperation(PatchRemove, d.Steps, va))
+		case InsertedDiff:
+			path := append(edits.bPath(d.Steps[:len(d.Steps)-1]), d.Steps[len(d.Steps)-1])
+			additions = append(additions, patchOperation(PatchAdd, path, vb))
+		case KeyNotFoundDiff:
+			path := edits.bPath(d.Steps)
+			switch {
+			case dv.B:
+				additions = append(additions, patchOperation(PatchAdd, path, vb))
+			case valueAt(vb, path).IsValid():
+				// B holds the key with a zero value
+				replacements = append(replacements, patchOperation(PatchReplace, path, vb))
+			default:
+				removals = append(removals, patchOperation(PatchRemove, d.Steps, va))
+			}
+		default:
+			path := edits.bPath(d.Steps)
+			for len(path) > 0 {
+				if _, isInterface := path[len(path)-1].(InterfaceStep); !isInterface {
+					break
+				}
+				path = path[:len(path)-1]
+			}
+			replacements = append(replacements, patchOperation(PatchReplace, path, vb))
+		}
+	}
+	sort.SliceStable(removals, func(i, j int) bool {
+		return comparePaths(removals[i].Path, removals[j].Path) > 0
+	})
+	sort.SliceStable(additions, func(i, j int) bool {
+		return comparePaths(additions[i].Path, additions[j].Path) < 0
+	})
+	operations := append(append(removals, additions...), replacements...)
+	return Patches{Operations: operations, a: a, b: b}
+}
+
+// JSONPatch returns the operations as a RFC 6902 JSON Patch document.
+func (p Patches) JSONPatch() ([]byte, error) {
+	operations := make([]map[string]interface{}, len(p.Operations))
+	for i, op := range p.Operations {
+		operations[i] = map[string]interface{}{"op": op.Op, "path": op.Pointer}
+		if op.Op != PatchRemove {
+			operations[i]["value"] = op.Value
+		}
+	}
+	return json.Marshal(operations)
+}
+
+// MergePatch returns the RFC 7386 JSON Merge Patch document turning the JSON encoding of the compared
+// values a into the one of b. As specified by the RFC, arrays are replaced as a whole and null values
+// cannot be set (null removes a key).
+func (p Patches) MergePatch() ([]byte, error) {
+	docA, err := jsonDocument(p.a)
+	if err != nil {
+		return nil, err
+	}
+	docB, err := jsonDocument(p.b)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(mergePatch(docA, docB))
+}
+
+// Apply function applies the patches operations on a copy of a (a is left unchanged) and returns it.
+// Struct unexported fields cannot be patched.
+func Apply(a interface{}, p Patches) (interface{}, error) {
+	v := reflect.ValueOf(a)
+	for _, op := range p.Operations {
+		if len(op.Path) == 0 && op.Op != PatchRemove {
+			v = reflect.ValueOf(op.Value)
+			continue
+		}
+		var err error
+		if v, err = applyOperation(v, op.Path, op); err != nil {
+			return nil, fmt.Errorf("%w : %s %v : %v", ErrInvalidPatch, op.Op, op.Path, err)
+		}
+	}
+	return interfaceOf(v), nil
+}
+
+func patchOperation(op string, path Path, root reflect.Value) PatchOperation {
+	operation := PatchOperation{Op: op, Path: path, Pointer: jsonPointer(root, path)}
+	if op != PatchRemove {
+		if v := valueAt(root, path); v.IsValid() && v.CanInterface() {
+			operation.Value = v.Interface()
+		}
+	}
+	return operation
+}
+
+// sliceEdits records the deleted (A) and inserted (B) indexes of slices, by slice path.
+type sliceEdits map[string]*sliceEdit
+
+type sliceEdit struct {
+	deleted  []int
+	inserted []int
+}
+
+func sliceEditsOf(diffs []Diff) sliceEdits {
+	edits := make(sliceEdits)
+	for _, d := range diffs {
+		_, deleted := d.Value.(DeletedDiff)
+		_, inserted := d.Value.(InsertedDiff)
+		if !deleted && !inserted {
+			continue
+		}
+		key := pathKey(d.Steps[:len(d.Steps)-1])
+		if edits[key] == nil {
+			edits[key] = &sliceEdit{}
+		}
+		index := d.Steps[len(d.Steps)-1].(IndexStep).Index
+		if deleted {
+			edits[key].deleted = append(edits[key].deleted, index)
+		} else {
+			edits[key].inserted = append(edits[key].inserted, index)
+		}
+	}
+	for _, e := range edits {
+		sort.Ints(e.deleted)
+		sort.Ints(e.inserted)
+	}
+	return edits
+}
+
+// bPath translates the A indexes of elements kept in edited slices to their B indexes.
+func (edits sliceEdits) bPath(p Path) Path {
+	translated := make(Path, len(p))
+	for i, s := range p {
+		if step, isIndex := s.(IndexStep); isIndex {
+			if e, found := edits[pathKey(p[:i])]; found {
+				s = IndexStep{e.bIndex(step.Index)}
+			}
+		}
+		translated[i] = s
+	}
+	return translated
+}
+
+func (e *sliceEdit) bIndex(aIndex int) int {
+	index := aIndex
+	for _, d := range e.deleted {
+		if d < aIndex {
+			index--
+		}
+	}
+	for _, i := range e.inserted {
+		if i > index {
+			break
+		}
+		index++
+	}
+	return index
+}
+
+func pathKey(p Path) string {
+	tokens := make([]string, len(p))
+	for i, s := range p {
+		tokens[i] = s.String()
+	}
+	return strings.Join(tokens, "\x00")
+}
+
+// comparePaths orders paths step by step, indexes being compared as numbers.
+func comparePaths(p, q Path) int {
+	for i := 0; i < len(p) && i < len(q); i++ {
+		stepP, isIndexP := p[i].(IndexStep)
+		stepQ, isIndexQ := q[i].(IndexStep)
+		switch {
+		case isIndexP && isIndexQ && stepP.Index != stepQ.Index:
+			return stepP.Index - stepQ.Index
+		case !isIndexP || !isIndexQ:
+			if c := strings.Compare(p[i].String(), q[i].String()); c != 0 {
+				return c
+			}
+		}
+	}
+	return len(p) - len(q)
+}
+
+// stepInto returns the value located by the step s from v (an invalid value when not found).
+func stepInto(v reflect.Value, s Step) reflect.Value {
+	if !v.IsValid() {
+		return v
+	}
+	switch step := s.(type) {
+	case FieldStep:
+		if v.Kind() == reflect.Struct {
+			return v.FieldByName(step.Name)
+		}
+	case MapKeyStep:
+		k := reflect.ValueOf(step.Key)
+		if v.Kind() == reflect.Map && k.IsValid() && k.Type().AssignableTo(v.Type().Key()) {
+			return v.MapIndex(k)
+		}
+	case IndexStep:
+		if (v.Kind() == reflect.Slice || v.Kind() == reflect.Array) && step.Index < v.Len() {
+			return v.Index(step.Index)
+		}
+	case DerefStep:
+		if v.Kind() == reflect.Ptr && !v.IsNil() {
+			return v.Elem()
+		}
+	case InterfaceStep:
+		if v.Kind() == reflect.Interface && !v.IsNil() {
+			return v.Elem()
+		}
+	}
+	return reflect.Value{}
+}
+
+func valueAt(v reflect.Value, p Path) reflect.Value {
+	for _, s := range p {
+		v = stepInto(v, s)
+	}
+	return v
+}
+
+// jsonPointer renders p as a JSON Pointer, struct fields of v being named by their json tag.
+func jsonPointer(v reflect.Value, p Path) string {
+	escaper := strings.NewReplacer("~", "~0", "/", "~1")
+	var sb strings.Builder
+	for _, s := range p {
+		switch step := s.(type) {
+		case FieldStep:
+			name := step.Name
+			if v.IsValid() && v.Kind() == reflect.Struct {
+				if f, found := v.Type().FieldByName(step.Name); found {
+					name = jsonFieldName(f)
+				}
+			}
+			sb.WriteString("/" + escaper.Replace(name))
+		case MapKeyStep:
+			sb.WriteString("/" + escaper.Replace(fmt.Sprintf("%v", step.Key)))
+		case IndexStep:
+			sb.WriteString("/" + strconv.Itoa(step.Index))
+		}
+		v = stepInto(v, s)
+	}
+	return sb.String()
+}
+
+func jsonFieldName(f reflect.StructField) string {
+	name := strings.Split(f.Tag.Get("json"), ",")[0]
+	if name == "" || name == "-" {
+		return f.Name
+	}
+	return name
+}
+
+func jsonDocument(v interface{}) (interface{}, error) {
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var doc interface{}
+	err = json.Unmarshal(encoded, &doc)
+	return doc, err
+}
+
+func mergePatch(a, b interface{}) interface{} {
+	objA, isObjA := a.(map[string]interface{})
+	objB, isObjB := b.(map[string]interface{})
+	if !isObjA || !isObjB {
+		return b
+	}
+	patch := make(map[string]interface{})
+	for k := range objA {
+		if _, found := objB[k]; !found {
+			patch[k] = nil
+		}
+	}
+	for k, vb := range objB {
+		if va, found := objA[k]; !found || !reflect.DeepEqual(va, vb) {
+			patch[k] = mergePatch(va, vb)
+		}
+	}
+	return patch
+}
+
+// applyOperation returns a copy of v on which op is applied at path (v is left unchanged).
+// nolint:gocyclo
+func applyOperation(v reflect.Value, path Path, op PatchOperation) (reflect.Value, error) {
+	if len(path) == 0 {
+		if op.Op == PatchRemove {
+			return v, errors.New("only map entries and slice elements can be removed")
+		}
+		return patchValue(op.Value, v.Type())
+	}
+	if !v.IsValid() {
+		return v, errors.New("path not found")
+	}
+	rest := path[1:]
+	switch step := path[0].(type) {
+	case FieldStep:
+		if v.Kind() != reflect.Struct {
+			return v, fmt.Errorf("%v is not a struct", v.Type())
+		}
+		if f, found := v.Type().FieldByName(step.Name); !found || f.PkgPath != "" {
+			return v, fmt.Errorf("field %s not found or unexported", step.Name)
+		}
+		c := copyOf(v)
+		patched, err := applyOperation(c.FieldByName(step.Name), rest, op)
+		if err != nil {
+			return v, err
+		}
+		c.FieldByName(step.Name).Set(patched)
+		return c, nil
+	case MapKeyStep:
+		if v.Kind() != reflect.Map {
+			return v, fmt.Errorf("%v is not a map", v.Type())
+		}
+		return applyOnMapEntry(v, step, rest, op)
+	case IndexStep:
+		if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+			return v, fmt.Errorf("%v is not a slice", v.Type())
+		}
+		return applyOnElement(v, step.Index, rest, op)
+	case DerefStep:
+		if v.Kind() != reflect.Ptr || v.IsNil() {
+			return v, errors.New("not a pointer or nil pointer")
+		}
+		patched, err := applyOperation(v.Elem(), rest, op)
+		if err != nil {
+			return v, err
+		}
+		p := reflect.New(v.Type().Elem())
+		p.Elem().Set(patched)
+		return p, nil
+	case InterfaceStep:
+		if v.Kind() != reflect.Interface || v.IsNil() {
+			return v, errors.New("not an interface or nil interface")
+		}
+		patched, err := applyOperation(v.Elem(), rest, op)
+		if err != nil {
+			return v, err
+		}
+		c := reflect.New(v.Type()).Elem()
+		c.Set(patched)
+		return c, nil
+	}
+	return v, fmt.Errorf("unsupported path step %v", path[0])
+}
+
+func applyOnMapEntry(v reflect.Value, step MapKeyStep, rest Path, op PatchOperation) (reflect.Value, error) {
+	key, err := patchValue(step.Key, v.Type().Key())
+	if err != nil {
+		return v, err
+	}
+	c := copyOf(v)
+	if c.IsNil() {
+		c = reflect.MakeMap(v.Type())
+	}
+	entry := c.MapIndex(key)
+	if len(rest) == 0 && op.Op == PatchRemove {
+		if !entry.IsValid() {
+			return v, fmt.Errorf("key %v not found", step.Key)
+		}
+		c.SetMapIndex(key, reflect.Value{})
+		return c, nil
+	}
+	if !entry.IsValid() {
+		if len(rest) != 0 || op.Op != PatchAdd {
+			return v, fmt.Errorf("key %v not found", step.Key)
+		}
+		entry = reflect.Zero(v.Type().Elem())
+	}
+	patched, err := applyOperation(entry, rest, op)
+	if err != nil {
+		return v, err
+	}
+	c.SetMapIndex(key, patched)
+	return c, nil
+}
+
+func applyOnElement(v reflect.Value, index int, rest Path, op PatchOperation) (reflect.Value, error) {
+	length := v.Len()
+	if len(rest) == 0 && op.Op != PatchReplace {
+		if v.Kind() == reflect.Array {
+			return v, errors.New("array elements cannot be added or removed")
+		}
+		if index < 0 || index > length || (op.Op == PatchRemove && index == length) {
+			return v, fmt.Errorf("index %d out of range", index)
+		}
+		if op.Op == PatchRemove {
+			c := reflect.MakeSlice(v.Type(), 0, length-1)
+			return reflect.AppendSlice(reflect.AppendSlice(c, v.Slice(0, index)), v.Slice(index+1, length)), nil
+		}
+		added, err := patchValue(op.Value, v.Type().Elem())
+		if err != nil {
+			return v, err
+		}
+		c := reflect.AppendSlice(reflect.MakeSlice(v.Type(), 0, length+1), v.Slice(0, index))
+		return reflect.AppendSlice(reflect.Append(c, added), v.Slice(index, length)), nil
+	}
+	if index < 0 || index >= length {
+		return v, fmt.Errorf("index %d out of range", index)
+	}
+	c := copyOf(v)
+	patched, err := applyOperation(c.Index(index), rest, op)
+	if err != nil {
+		return v, err
+	}
+	c.Index(index).Set(patched)
+	return c, nil
+}
+
+// copyOf returns a settable shallow copy of v (slices and maps being copied).
+func copyOf(v reflect.Value) reflect.Value {
+	switch {
+	case v.Kind() == reflect.Slice && !v.IsNil():
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		reflect.Copy(c, v)
+		return c
+	case v.Kind() == reflect.Map && !v.IsNil():
+		c := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			c.SetMapIndex(iter.Key(), iter.Value())
+		}
+		return c
+	}
+	c := reflect.New(v.Type()).Elem()
+	c.Set(v)
+	return c
+}
+
+func patchValue(value interface{}, t reflect.Type) (reflect.Value, error) {
+	if value == nil {
+		switch t.Kind() {
+		case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice:
+			return reflect.Zero(t), nil
+		}
+		return reflect.Value{}, fmt.Errorf("nil value cannot be assigned to %v", t)
+	}
+	v := reflect.ValueOf(value)
+	if !v.Type().AssignableTo(t) {
+		return v, fmt.Errorf("value of type %v cannot be assigned to %v", v.Type(), t)
+	}
+	return v, nil
+}
@@ -0,0 +1,172 @@
+package diff_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/elethoughts-code/goasserts/diff"
+)
+
+type patchItem struct {
+	ID   int      `json:"id"`
+	Tags []string `json:"tags,omitempty"`
+}
+
+type patchConfig struct {
+	Name     string                 `json:"name"`
+	Port     *int                   `json:"port"`
+	Items    []patchItem            `json:"items"`
+	Labels   map[string]string      `json:"labels"`
+	Extra    map[string]interface{} `json:"extra"`
+	Matrix   [][]int                `json:"matrix"`
+	Fallback interface{}            `json:"fallback"`
+}
+
+func Test_Patch_Apply_round_trip(t *testing.T) {
+	// Given
+	port := 8080
+	otherPort := 9090
+	large, otherLarge := make([]int, 5000), make([]int, 4999)
+	for i := range otherLarge {
+		otherLarge[i] = -i - 1
+	}
+	base := patchConfig{
+		Name: "a",
+		Port: &port,
+		Items: []patchItem{
+			{ID: 1, Tags: []string{"x", "y", "z"}},
+			{ID: 2},
+			{ID: 3, Tags: []string{"t"}},
+			{ID: 4},
+		},
+		Labels:   map[string]string{"env": "dev", "team": "core"},
+		Extra:    map[string]interface{}{"depth": 1, "nested": map[string]interface{}{"on": true}},
+		Matrix:   [][]int{{1, 2, 3}, {4, 5}, {6}},
+		Fallback: "none",
+	}
+	testCases := []struct {
+		a interface{}
+		b interface{}
+	}{
+		{a: base, b: base},
+		{
+			a: base,
+			b: patchConfig{
+				Name: "b",
+				Port: &otherPort,
+				Items: []patchItem{
+					{ID: 0},
+					{ID: 1, Tags: []string{"w", "x", "z", "zz"}},
+					{ID: 3, Tags: []string{"t", "u"}},
+					{ID: 5},
+				},
+				Labels:   map[string]string{"env": "prod", "owner": "me"},
+				Extra:    map[string]interface{}{"depth": "deep", "nested": map[string]interface{}{"on": false}},
+				Matrix:   [][]int{{0}, {1, 3}, {4, 5, 6}},
+				Fallback: 12,
+			},
+		},
+		{
+			a: base,
+			b: patchConfig{Labels: map[string]string{"env": "dev"}, Items: []patchItem{{ID: 2}}, Matrix: [][]int{}},
+		},
+		{a: &base, b: &patchConfig{Name: "ptr", Items: base.Items[1:]}},
+		{a: []int{1, 2, 3, 4, 5}, b: []int{2, 4, 6}},
+		{a: large, b: otherLarge},
+		{a: map[int]bool{1: true, 2: true}, b: map[int]bool{2: true, 3: true}},
+		{a: nil, b: base},
+		{a: base, b: nil},
+		{a: 12, b: "twelve"},
+	}
+
+	for i, tc := range testCases {
+		// When
+		patched, err := diff.Apply(tc.a, diff.Patch(tc.a, tc.b))
+
+		// Then
+		if err != nil {
+			t.Errorf("%d : unexpected error %v", i, err)
+		}
+		if result := diff.Diffs(patched, tc.b); len(result) != 0 {
+			t.Errorf("%d : patched value differs %v", i, result)
+		}
+	}
+	if !reflect.DeepEqual(base.Items[0].Tags, []string{"x", "y", "z"}) || *base.Port != 8080 || len(base.Labels) != 2 {
+		t.Errorf("applied value was modified %+v", base)
+	}
+}
+
+func Test_Patch_operations(t *testing.T) {
+	// Given
+	a := patchConfig{
+		Name:   "a",
+		Items:  []patchItem{{ID: 1, Tags: []string{"t"}}, {ID: 2}, {ID: 3}},
+		Labels: map[string]string{"env": "dev", "team/x": "core"},
+	}
+	b := patchConfig{
+		Name:   "b",
+		Items:  []patchItem{{ID: 1, Tags: []string{"t", "u"}}, {ID: 3}},
+		Labels: map[string]string{"env": "dev", "owner": "me"},
+	}
+
+	// When
+	patch := diff.Patch(a, b)
+	jsonPatch, err := patch.JSONPatch()
+	mergePatch, mergeErr := patch.MergePatch()
+
+	// Then
+	if err != nil || string(jsonPatch) != `[`+
+		`{"op":"remove","path":"/labels/team~1x"},`+
+		`{"op":"remove","path":"/items/1"},`+
+		`{"op":"add","path":"/items/0/tags/1","value":"u"},`+
+		`{"op":"add","path":"/labels/owner","value":"me"},`+
+		`{"op":"replace","path":"/name","value":"b"}]` {
+		t.Errorf("unexpected JSON patch %s %v", jsonPatch, err)
+	}
+	if mergeErr != nil || string(mergePatch) != `{"items":[{"id":1,"tags":["t","u"]},{"id":3}],`+
+		`"labels":{"owner":"me","team/x":null},"name":"b"}` {
+		t.Errorf("unexpected merge patch %s %v", mergePatch, mergeErr)
+	}
+	if !reflect.DeepEqual(patch.Operations[2].Path.Strings(), path("[Items]", "[0]", "[Tags]", "[1]")) {
+		t.Errorf("unexpected operation path %v", patch.Operations[2].Path)
+	}
+}
+
+func Test_Apply_invalid_patch(t *testing.T) {
+	// Given
+	type unexported struct {
+		value int
+	}
+	testCases := []struct {
+		a     interface{}
+		patch diff.Patches
+	}{
+		{
+			a:     unexported{value: 1},
+			patch: diff.Patch(unexported{value: 1}, unexported{value: 2}),
+		},
+		{
+			a: []int{1},
+			patch: diff.Patches{Operations: []diff.PatchOperation{
+				{Op: diff.PatchRemove, Path: diff.Path{diff.IndexStep{Index: 3}}},
+			}},
+		},
+		{
+			a: map[string]int{"a": 1},
+			patch: diff.Patches{Operations: []diff.PatchOperation{
+				{Op: diff.PatchReplace, Path: diff.Path{diff.MapKeyStep{Key: "a"}}, Value: "one"},
+			}},
+		},
+	}
+
+	for i, tc := range testCases {
+		// When
+		_, err := diff.Apply(tc.a, tc.patch)
+
+		// Then
+		if !errors.Is(err, diff.ErrInvalidPatch) {
+			t.Errorf("%d : unexpected error %v", i, err)
+		}
+	}
+}
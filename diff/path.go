@@ -0,0 +1,150 @@
+package diff
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Step is a Path element : FieldStep, MapKeyStep, IndexStep, DerefStep or InterfaceStep.
+// Its String method renders the step (eg. "[Name]", `["key"]`, "[3]", "[&]"), map keys being rendered
+// with their Go syntax so that "1" and 1 keys are distinguished.
+type Step interface {
+	String() string
+}
+
+// FieldStep is a struct field access.
+type FieldStep struct {
+	Name string
+}
+
+func (s FieldStep) String() string {
+	return fmt.Sprintf("[%s]", s.Name)
+}
+
+// MapKeyStep is a map entry access (including string keyed maps compared to structs by Similar).
+type MapKeyStep struct {
+	Key interface{}
+}
+
+func (s MapKeyStep) String() string {
+	return fmt.Sprintf("[%#v]", s.Key)
+}
+
+// IndexStep is a slice or array element access.
+type IndexStep struct {
+	Index int
+}
+
+func (s IndexStep) String() string {
+	return fmt.Sprintf("[%d]", s.Index)
+}
+
+// DerefStep is a pointer dereference.
+type DerefStep struct{}
+
+func (s DerefStep) String() string {
+	return "[&]"
+}
+
+// InterfaceStep is an interface unwrapping, Type being the dynamic type of the wrapped value.
+type InterfaceStep struct {
+	Type reflect.Type
+}
+
+func (s InterfaceStep) String() string {
+	return "[interface{}]"
+}
+
+// Path locates a difference from the compared root values.
+type Path []Step
+
+// Strings returns the path legacy tokens (eg. ["[Address]" "[Lines]" "[0]" "[key]"]) as found in Diff.Path.
+func (p Path) Strings() []string {
+	tokens := make([]string, len(p))
+	for i, s := range p {
+		tokens[i] = legacyToken(s)
+	}
+	return tokens
+}
+
+// legacyToken renders s as a Diff.Path token, map keys being rendered as is.
+func legacyToken(s Step) string {
+	if k, isMapKey := s.(MapKeyStep); isMapKey {
+		return fmt.Sprintf("[%v]", k.Key)
+	}
+	return s.String()
+}
+
+// String renders the path with the Go syntax from a root variable v (eg. `v.Address.Lines[0]`, `v["key"]`).
+func (p Path) String() string {
+	rendered := "v"
+	for i, s := range p {
+		switch step := s.(type) {
+		case FieldStep:
+			rendered += "." + step.Name
+		case MapKeyStep:
+			rendered += fmt.Sprintf("[%#v]", step.Key)
+		case IndexStep:
+			rendered += fmt.Sprintf("[%d]", step.Index)
+		case DerefStep:
+			// Fields are accessed through pointers without explicit dereference
+			if i+1 < len(p) {
+				if _, isField := p[i+1].(FieldStep); isField {
+					continue
+				}
+			}
+			rendered = "(*" + rendered + ")"
+		case InterfaceStep:
+			if step.Type != nil {
+				rendered += fmt.Sprintf(".(%v)", step.Type)
+			}
+		}
+	}
+	return rendered
+}
+
+var jsonPathIdentifierRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// JSONPath renders the path as a JSONPath (eg. `$.Address.Lines[0]`, `$['a key']`).
+// Dereferences and interfaces are not rendered.
+func (p Path) JSONPath() string {
+	var sb strings.Builder
+	sb.WriteString("$")
+	for _, s := range p {
+		switch step := s.(type) {
+		case FieldStep:
+			sb.WriteString("." + step.Name)
+		case MapKeyStep:
+			key := fmt.Sprintf("%v", step.Key)
+			if jsonPathIdentifierRe.MatchString(key) {
+				sb.WriteString("." + key)
+			} else {
+				sb.WriteString("['" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(key) + "']")
+			}
+		case IndexStep:
+			sb.WriteString("[" + strconv.Itoa(step.Index) + "]")
+		}
+	}
+	return sb.String()
+}
+
+// JSONPointer renders the path as a RFC 6901 JSON Pointer (eg. "/Address/Lines/0", "" being the root).
+// Dereferences and interfaces are not rendered.
+func (p Path) JSONPointer() string {
+	escaper := strings.NewReplacer("~", "~0", "/", "~1")
+	var sb strings.Builder
+	for _, s := range p {
+		switch step := s.(type) {
+		case FieldStep:
+			sb.WriteString("/" + escaper.Replace(step.Name))
+		case MapKeyStep:
+			sb.WriteString("/" + escaper.Replace(fmt.Sprintf("%v", step.Key)))
+		case IndexStep:
+			sb.WriteString("/" + strconv.Itoa(step.Index))
+		}
+	}
+	return sb.String()
+}
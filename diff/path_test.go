@@ -0,0 +1,142 @@
+package diff_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/elethoughts-code/goasserts/diff"
+)
+
+func Test_Path_renderers(t *testing.T) {
+	// Given
+	testCases := []struct {
+		path        diff.Path
+		strings     []string
+		goSyntax    string
+		jsonPath    string
+		jsonPointer string
+	}{
+		{
+			path:        diff.Path{},
+			strings:     []string{},
+			goSyntax:    "v",
+			jsonPath:    "$",
+			jsonPointer: "",
+		},
+		{
+			path:        diff.Path{diff.FieldStep{"Address"}, diff.FieldStep{"Lines"}, diff.IndexStep{0}},
+			strings:     []string{"[Address]", "[Lines]", "[0]"},
+			goSyntax:    "v.Address.Lines[0]",
+			jsonPath:    "$.Address.Lines[0]",
+			jsonPointer: "/Address/Lines/0",
+		},
+		{
+			path:        diff.Path{diff.MapKeyStep{"a/b~c"}, diff.MapKeyStep{"it's"}, diff.MapKeyStep{1}},
+			strings:     []string{"[a/b~c]", "[it's]", "[1]"},
+			goSyntax:    `v["a/b~c"]["it's"][1]`,
+			jsonPath:    `$['a/b~c']['it\'s']['1']`,
+			jsonPointer: "/a~1b~0c/it's/1",
+		},
+		{
+			path: diff.Path{diff.DerefStep{}, diff.FieldStep{"A"}, diff.InterfaceStep{reflect.TypeOf(0)},
+				diff.MapKeyStep{"k"}, diff.DerefStep{}},
+			strings:     []string{"[&]", "[A]", "[interface{}]", "[k]", "[&]"},
+			goSyntax:    `(*v.A.(int)["k"])`,
+			jsonPath:    "$.A.k",
+			jsonPointer: "/A/k",
+		},
+	}
+
+	for i, tc := range testCases {
+		// Then
+		if !reflect.DeepEqual(tc.path.Strings(), tc.strings) {
+			t.Errorf("%d : unexpected strings %v", i, tc.path.Strings())
+		}
+		if tc.path.String() != tc.goSyntax {
+			t.Errorf("%d : unexpected Go syntax %s", i, tc.path.String())
+		}
+		if tc.path.JSONPath() != tc.jsonPath {
+			t.Errorf("%d : unexpected JSONPath %s", i, tc.path.JSONPath())
+		}
+		if tc.path.JSONPointer() != tc.jsonPointer {
+			t.Errorf("%d : unexpected JSON Pointer %s", i, tc.path.JSONPointer())
+		}
+	}
+}
+
+func Test_Diffs_typed_path_steps(t *testing.T) {
+	// Given
+	type inner struct {
+		Values map[int]interface{}
+	}
+	type outer struct {
+		Inner *inner
+		List  []string
+	}
+	a := outer{Inner: &inner{Values: map[int]interface{}{1: "a"}}, List: []string{"x", "y"}}
+	b := outer{Inner: &inner{Values: map[int]interface{}{1: "b"}}, List: []string{"x", "z"}}
+
+	// When
+	result := diff.Diffs(a, b)
+
+	// Then
+	expected := []diff.Path{
+		{diff.FieldStep{"Inner"}, diff.DerefStep{}, diff.FieldStep{"Values"}, diff.MapKeyStep{1},
+			diff.InterfaceStep{reflect.TypeOf("")}},
+		{diff.FieldStep{"List"}, diff.IndexStep{1}},
+	}
+	if len(result) != len(expected) {
+		t.Fatalf("unexpected diffs %v", result)
+	}
+	for i := range expected {
+		if !reflect.DeepEqual(result[i].Steps, expected[i]) {
+			t.Errorf("%d : unexpected path %#v", i, result[i].Steps)
+		}
+	}
+}
+
+func Test_Similar_typed_path_steps(t *testing.T) {
+	// Given
+	type item struct {
+		Name string
+	}
+	a := map[string]interface{}{"items": []item{{Name: "a"}}, "counts": map[int]int{1: 1}}
+	b := map[string]interface{}{
+		"items":  []map[string]interface{}{{"Name": "b", "Extra": 1}},
+		"counts": map[int]int{1: 2},
+	}
+
+	// When
+	result := diff.Similar(a, b, false)
+
+	// Then
+	expected := []diff.Path{
+		{diff.MapKeyStep{"counts"}, diff.MapKeyStep{1}},
+		{diff.MapKeyStep{"items"}, diff.IndexStep{0}, diff.FieldStep{"Name"}},
+		{diff.MapKeyStep{"items"}, diff.IndexStep{0}, diff.MapKeyStep{"Extra"}},
+	}
+	if len(result) != len(expected) {
+		t.Fatalf("unexpected diffs %v", result)
+	}
+	for i := range expected {
+		if !reflect.DeepEqual(result[i].Steps, expected[i]) {
+			t.Errorf("%d : unexpected path %#v", i, result[i].Steps)
+		}
+	}
+	if result[1].Steps.JSONPointer() != "/items/0/Name" || result[1].Steps.String() != `v["items"][0].Name` {
+		t.Errorf("unexpected rendering %s %s", result[1].Steps.JSONPointer(), result[1].Steps.String())
+	}
+}
+
+func Test_MapKeyStep_should_distinguish_key_types(t *testing.T) {
+	// Given
+	stringKey, intKey := diff.MapKeyStep{Key: "1"}, diff.MapKeyStep{Key: 1}
+
+	// Then
+	if stringKey.String() != `["1"]` || intKey.String() != "[1]" {
+		t.Errorf("unexpected steps %s %s", stringKey, intKey)
+	}
+	if !reflect.DeepEqual(diff.Path{stringKey}.Strings(), diff.Path{intKey}.Strings()) {
+		t.Errorf("legacy tokens should be kept %v %v", diff.Path{stringKey}.Strings(), diff.Path{intKey}.Strings())
+	}
+}
@@ -0,0 +1,228 @@
+package diff
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+var (
+	ErrUnknownPlaceholder         = errors.New("unknown placeholder")
+	ErrInvalidPlaceholderArgument = errors.New("invalid placeholder argument")
+)
+
+// PlaceholderFactory creates the matcher of a placeholder from its argument : "^a.*" for "{{regex:^a.*}}",
+// ">0" for "{{number>0}}" and "" for "{{any}}".
+type PlaceholderFactory func(arg string) (SimilarMatcher, error)
+
+// Placeholders is a registry of named expectation placeholders : strings of the form "{{name}}",
+// "{{name:arg}}" or "{{name<op><arg>}}" that are resolved as SimilarMatcher (see WithPlaceholders).
+//
+// Built-in placeholders are :
+//
+// - "{{any}}", "{{notnil}}", "{{string}}", "{{bool}}", "{{uuid}}" and "{{rfc3339}}".
+//
+// - "{{number}}" or "{{number<op><value>}}" with <op> being one of >, >=, <, <=, = and != (eg. "{{number>0}}").
+//
+// - "{{regex:<expr>}}" and "{{len:<n>}}".
+//
+// - "{{optional:<placeholder>}}" (eg. "{{optional:uuid}}") accepting absent keys.
+//
+// - "{{capture:<name>}}" matching any value and storing it under name (see WithCaptures).
+type Placeholders struct {
+	mu        sync.RWMutex
+	factories map[string]PlaceholderFactory
+}
+
+// DefaultPlaceholders registry holds the built-in placeholders and the ones added by RegisterPlaceholder.
+var DefaultPlaceholders = NewPlaceholders()
+
+// NewPlaceholders function creates a registry with the built-in placeholders.
+func NewPlaceholders() *Placeholders {
+	p := &Placeholders{
+		factories: make(map[string]PlaceholderFactory),
+	}
+	p.Register("any", noArgPlaceholder(Any))
+	p.Register("notnil", noArgPlaceholder(NotNil))
+	p.Register("string", noArgPlaceholder(func() SimilarMatcher { return AnyOfType("") }))
+	p.Register("bool", noArgPlaceholder(func() SimilarMatcher { return AnyOfType(false) }))
+	p.Register("uuid", noArgPlaceholder(UUID))
+	p.Register("rfc3339", noArgPlaceholder(RFC3339))
+	p.Register("number", numberPlaceholder)
+	p.Register("regex", regexPlaceholder)
+	p.Register("len", lenPlaceholder)
+	p.Register("optional", p.optionalPlaceholder)
+	p.Register("capture", capturePlaceholder)
+	return p
+}
+
+// RegisterPlaceholder function registers a placeholder on the DefaultPlaceholders registry.
+func RegisterPlaceholder(name string, f PlaceholderFactory) {
+	DefaultPlaceholders.Register(name, f)
+}
+
+// Register adds (or replaces) the name placeholder. Names are made of letters, digits and underscores.
+func (p *Placeholders) Register(name string, f PlaceholderFactory) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.factories[name] = f
+}
+
+// WithPlaceholders option resolves the b side (expectation) placeholder strings using the p registry.
+// Unknown or invalid placeholders are reported as PlaceholderDiff.
+func WithPlaceholders(p *Placeholders) SimilarOption {
+	return func(ctx *similarContext) {
+		ctx.placeholders = p
+	}
+}
+
+// Captures holds the values matched by "{{capture:<name>}}" placeholders during a comparison (see WithCaptures).
+type Captures struct {
+	mu     sync.RWMutex
+	values map[string]interface{}
+}
+
+// NewCaptures function creates an empty Captures, meant to be used by a single comparison.
+func NewCaptures() *Captures {
+	return &Captures{values: make(map[string]interface{})}
+}
+
+// Get returns the value captured by the last match of a "{{capture:name}}" placeholder.
+func (c *Captures) Get(name string) (interface{}, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	v, ok := c.values[name]
+	return v, ok
+}
+
+func (c *Captures) set(name string, v interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.values[name] = v
+}
+
+// WithCaptures option stores the values matched by "{{capture:<name>}}" placeholders into c.
+// Without it, capture placeholders match any value without storing it.
+func WithCaptures(c *Captures) SimilarOption {
+	return func(ctx *similarContext) {
+		ctx.captured = c
+	}
+}
+
+var placeholderRe = regexp.MustCompile(`^\{\{\s*([A-Za-z_][A-Za-z0-9_]*)(.*?)\s*\}\}$`)
+
+// resolve returns the matcher of s, ok being false when s is not a placeholder.
+func (p *Placeholders) resolve(s string) (SimilarMatcher, bool, error) {
+	groups := placeholderRe.FindStringSubmatch(s)
+	if groups == nil {
+		return SimilarMatcher{}, false, nil
+	}
+	name, arg := groups[1], strings.TrimPrefix(strings.TrimSpace(groups[2]), ":")
+	p.mu.RLock()
+	f, exists := p.factories[name]
+	p.mu.RUnlock()
+	if !exists {
+		return SimilarMatcher{}, false, fmt.Errorf("%w : %s", ErrUnknownPlaceholder, name)
+	}
+	m, err := f(arg)
+	if err != nil {
+		return SimilarMatcher{}, false, err
+	}
+	return m, true, nil
+}
+
+func noArgPlaceholder(m func() SimilarMatcher) PlaceholderFactory {
+	return func(arg string) (SimilarMatcher, error) {
+		if arg != "" {
+			return SimilarMatcher{}, fmt.Errorf("%w : no argument expected, got %s", ErrInvalidPlaceholderArgument, arg)
+		}
+		return m(), nil
+	}
+}
+
+var numberConstraintRe = regexp.MustCompile(`^(>=|<=|!=|>|<|=)\s*(.+)$`)
+
+func numberPlaceholder(arg string) (SimilarMatcher, error) {
+	if arg == "" {
+		return CheckMatcher("number", func(v interface{}) (bool, string) {
+			if _, ok := numberOf(v); !ok {
+				return false, "value is not a number"
+			}
+			return true, ""
+		}), nil
+	}
+	groups := numberConstraintRe.FindStringSubmatch(arg)
+	if groups == nil {
+		return SimilarMatcher{}, fmt.Errorf("%w : number%s", ErrInvalidPlaceholderArgument, arg)
+	}
+	bound, err := strconv.ParseFloat(groups[2], 64)
+	if err != nil {
+		return SimilarMatcher{}, fmt.Errorf("%w : number%s", ErrInvalidPlaceholderArgument, arg)
+	}
+	op := groups[1]
+	return CheckMatcher("number"+op+groups[2], func(v interface{}) (bool, string) {
+		n, ok := numberOf(v)
+		if !ok {
+			return false, "value is not a number"
+		}
+		switch op {
+		case ">=":
+			return n >= bound, ""
+		case "<=":
+			return n <= bound, ""
+		case "!=":
+			return n != bound, ""
+		case ">":
+			return n > bound, ""
+		case "<":
+			return n < bound, ""
+		default:
+			return n == bound, ""
+		}
+	}), nil
+}
+
+func numberOf(v interface{}) (float64, bool) {
+	rv := reflect.ValueOf(v)
+	return asNumeric(rv, rv.Kind())
+}
+
+func regexPlaceholder(arg string) (SimilarMatcher, error) {
+	if _, err := regexp.Compile(arg); err != nil {
+		return SimilarMatcher{}, fmt.Errorf("%w : %v", ErrInvalidPlaceholderArgument, err)
+	}
+	return Regexp(arg), nil
+}
+
+func lenPlaceholder(arg string) (SimilarMatcher, error) {
+	n, err := strconv.Atoi(arg)
+	if err != nil {
+		return SimilarMatcher{}, fmt.Errorf("%w : len:%s", ErrInvalidPlaceholderArgument, arg)
+	}
+	return Len(n), nil
+}
+
+func (p *Placeholders) optionalPlaceholder(arg string) (SimilarMatcher, error) {
+	m, ok, err := p.resolve("{{" + arg + "}}")
+	if err != nil {
+		return SimilarMatcher{}, err
+	}
+	if !ok {
+		return SimilarMatcher{}, fmt.Errorf("%w : optional:%s", ErrInvalidPlaceholderArgument, arg)
+	}
+	return Optional(m), nil
+}
+
+func capturePlaceholder(arg string) (SimilarMatcher, error) {
+	if arg == "" {
+		return SimilarMatcher{}, fmt.Errorf("%w : capture name is missing", ErrInvalidPlaceholderArgument)
+	}
+	m := Any()
+	m.Name = "capture:" + arg
+	m.captureAs = arg
+	return m, nil
+}
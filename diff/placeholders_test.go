@@ -0,0 +1,141 @@
+package diff_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/elethoughts-code/goasserts/diff"
+)
+
+func Test_Placeholders_should_match(t *testing.T) {
+	// Given
+	p := diff.NewPlaceholders()
+	testCases := []struct {
+		a       interface{}
+		b       string
+		matches bool
+	}{
+		{a: 1, b: "{{any}}", matches: true},
+		{a: nil, b: "{{any}}", matches: true},
+		{a: nil, b: "{{notnil}}", matches: false},
+		{a: "a", b: "{{ string }}", matches: true},
+		{a: 1, b: "{{string}}", matches: false},
+		{a: true, b: "{{bool}}", matches: true},
+		{a: "123e4567-e89b-12d3-a456-426614174000", b: "{{uuid}}", matches: true},
+		{a: "2021-03-04T10:20:30Z", b: "{{rfc3339}}", matches: true},
+		{a: 3, b: "{{number}}", matches: true},
+		{a: "3", b: "{{number}}", matches: false},
+		{a: 3.5, b: "{{number>0}}", matches: true},
+		{a: 0, b: "{{number>0}}", matches: false},
+		{a: 0, b: "{{number >= 0}}", matches: true},
+		{a: -1, b: "{{number<0}}", matches: true},
+		{a: 2, b: "{{number<=1.5}}", matches: false},
+		{a: 2, b: "{{number=2}}", matches: true},
+		{a: 2, b: "{{number!=2}}", matches: false},
+		{a: "abc", b: "{{regex:^a.*}}", matches: true},
+		{a: "cba", b: "{{regex:^a.*}}", matches: false},
+		{a: []int{1, 2}, b: "{{len:2}}", matches: true},
+		{a: "{{literal", b: "{{literal", matches: true},
+	}
+
+	for i, tc := range testCases {
+		// When
+		result := diff.SimilarWith(tc.a, tc.b, diff.WithPlaceholders(p))
+		// Then
+		if (len(result) == 0) != tc.matches {
+			t.Errorf("%d : %s matches %v, unexpected diffs %v", i, tc.b, tc.a, result)
+		}
+	}
+}
+
+func Test_Placeholders_should_be_ignored_without_option(t *testing.T) {
+	// When
+	result := diff.SimilarWith("a", "{{any}}")
+	// Then
+	if !reflect.DeepEqual(withoutSteps(result), rootLevelDiffs(diff.CommonDiff{A: "a", B: "{{any}}"})) {
+		t.Errorf("unexpected diffs %v", result)
+	}
+}
+
+func Test_Placeholders_should_report_invalid_placeholders(t *testing.T) {
+	// Given
+	p := diff.NewPlaceholders()
+	testCases := []struct {
+		b   string
+		err error
+	}{
+		{b: "{{unknown}}", err: diff.ErrUnknownPlaceholder},
+		{b: "{{any:1}}", err: diff.ErrInvalidPlaceholderArgument},
+		{b: "{{number~1}}", err: diff.ErrInvalidPlaceholderArgument},
+		{b: "{{number>a}}", err: diff.ErrInvalidPlaceholderArgument},
+		{b: "{{regex:(}}", err: diff.ErrInvalidPlaceholderArgument},
+		{b: "{{len:a}}", err: diff.ErrInvalidPlaceholderArgument},
+		{b: "{{optional:unknown}}", err: diff.ErrUnknownPlaceholder},
+		{b: "{{capture}}", err: diff.ErrInvalidPlaceholderArgument},
+	}
+
+	for i, tc := range testCases {
+		// When
+		result := diff.SimilarWith(map[string]interface{}{"A": 1}, map[string]interface{}{"A": tc.b},
+			diff.WithPlaceholders(p))
+		// Then
+		if len(result) != 1 {
+			t.Fatalf("%d : unexpected diffs %v", i, result)
+		}
+		pd, ok := result[0].Value.(diff.PlaceholderDiff)
+		if !ok || pd.Placeholder != tc.b || !errors.Is(pd.Err, tc.err) ||
+			!reflect.DeepEqual(result[0].Path, path("[A]")) {
+			t.Errorf("%d : unexpected diff %v", i, result[0])
+		}
+	}
+}
+
+func Test_Placeholders_optional_and_capture(t *testing.T) {
+	// Given
+	p := diff.NewPlaceholders()
+	b := map[string]interface{}{
+		"id":      "{{capture:userId}}",
+		"name":    "{{string}}",
+		"comment": "{{optional:string}}",
+	}
+
+	captures := diff.NewCaptures()
+
+	// When
+	result := diff.SimilarWith(map[string]interface{}{"id": 42.0, "name": "bob"}, b, diff.WithPlaceholders(p),
+		diff.WithCaptures(captures))
+	uncaptured := diff.SimilarWith(map[string]interface{}{"id": 43.0, "name": "bob"}, b, diff.WithPlaceholders(p))
+
+	// Then
+	if len(result) != 0 || len(uncaptured) != 0 {
+		t.Errorf("unexpected diffs %v %v", result, uncaptured)
+	}
+	if id, ok := captures.Get("userId"); !ok || id != 42.0 {
+		t.Errorf("unexpected captured value %v", id)
+	}
+	if _, ok := captures.Get("other"); ok {
+		t.Error("other should not be captured")
+	}
+}
+
+func Test_Placeholders_custom_registration(t *testing.T) {
+	// Given
+	p := diff.NewPlaceholders()
+	p.Register("even", func(arg string) (diff.SimilarMatcher, error) {
+		return diff.Matcher("even", func(v interface{}) bool {
+			n, ok := v.(int)
+			return ok && n%2 == 0
+		}), nil
+	})
+
+	// When
+	even := diff.SimilarWith([]int{2, 4}, []string{"{{even}}", "{{even}}"}, diff.WithPlaceholders(p))
+	odd := diff.SimilarWith([]int{2, 3}, []string{"{{even}}", "{{even}}"}, diff.WithPlaceholders(p))
+
+	// Then
+	expected := diffs(d(path("[1]"), diff.MatcherDiff{Name: "even", Value: 3}))
+	if len(even) != 0 || !reflect.DeepEqual(withoutSteps(odd), expected) {
+		t.Errorf("unexpected diffs %v %v", even, odd)
+	}
+}
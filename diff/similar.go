@@ -3,35 +3,47 @@ package diff
 import (
 	"fmt"
 	"reflect"
+	"sort"
 )
 
 // Similar function returns all extracted dissimilarities between two variables a and b.
 // Similar uses field based equality check :
 //
 // - It do not check types.
-// - It compares structs to maps.
+// - It compares structs to maps whose keys are all strings (including yaml.v2 interface{} keyed maps).
+// - Maps with other keys are compared key by key, keys being matched by value (see Compare).
 // - Empty slices and maps are equal to nils.
+//
+// When checkUnordered is set, slices and arrays elements order is ignored (see Unordered option).
 func Similar(a, b interface{}, checkUnordered bool) (diffs []Diff) {
+	if checkUnordered {
+		return SimilarWith(a, b, Unordered())
+	}
+	return SimilarWith(a, b)
+}
+
+// SimilarWith function is the Similar function configured by options (see Unordered and UnorderedAt).
+func SimilarWith(a, b interface{}, opts ...SimilarOption) (diffs []Diff) {
 	diffs = make([]Diff, 0)
-	path := make([]string, 0)
+	path := make(Path, 0)
 	if a == nil && b == nil {
 		return diffs
 	}
 
-	if a == nil {
-		diffs = append(diffs, Diff{Path: path, Value: CommonDiff{a, b}})
+	ctx := newSimilarContext(opts)
+	vb := reflect.ValueOf(b)
+
+	if _, isMatcher, err := ctx.matcherOf(vb); a == nil && !isMatcher && err == nil {
+		diffs = append(diffs, newDiff(path, CommonDiff{a, b}))
 		return diffs
 	}
 
 	if b == nil {
-		diffs = append(diffs, Diff{Path: path, Value: CommonDiff{a, b}})
+		diffs = append(diffs, newDiff(path, CommonDiff{a, b}))
 		return diffs
 	}
 
-	va := reflect.ValueOf(a)
-	vb := reflect.ValueOf(b)
-
-	findSimilarityDiffs(path, va, vb, &diffs, make(map[similarVisit]bool), checkUnordered)
+	findSimilarityDiffs(path, reflect.ValueOf(a), vb, &diffs, ctx)
 
 	return diffs
 }
@@ -57,12 +69,30 @@ func isIndexed(v reflect.Value, k reflect.Kind) (int, bool) {
 	}
 }
 
+// hasStringKeys returns true when v is a string keyed map or an interface{} keyed map
+// whose keys are all strings at runtime.
+func hasStringKeys(v reflect.Value) bool {
+	switch v.Type().Key().Kind() {
+	case reflect.String:
+		return true
+	case reflect.Interface:
+		for _, k := range v.MapKeys() {
+			if interfaceDereference(k).Kind() != reflect.String {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
+
 func isFielded(v reflect.Value, k reflect.Kind) (map[string]reflect.Value, bool) {
 	switch {
-	case k == reflect.Map && v.Type().Key().Kind() == reflect.String:
+	case k == reflect.Map && hasStringKeys(v):
 		fields := make(map[string]reflect.Value, v.Len())
 		for _, k := range v.MapKeys() {
-			fields[k.String()] = v.MapIndex(k)
+			fields[interfaceDereference(k).String()] = v.MapIndex(k)
 		}
 		return fields, true
 	case k == reflect.Struct:
@@ -79,91 +109,52 @@ func isFielded(v reflect.Value, k reflect.Kind) (map[string]reflect.Value, bool)
 	}
 }
 
-func isNil(v reflect.Value, k reflect.Kind) bool {
-	switch k {
-	case reflect.Chan, reflect.Func, reflect.Map, reflect.Ptr, reflect.UnsafePointer, reflect.Interface, reflect.Slice:
-		return v.IsNil()
-	default:
-		return false
+// fieldStep returns the path step of a fielded value name : a struct field or a string map key.
+func fieldStep(name string, k reflect.Kind) Step {
+	if k == reflect.Struct {
+		return FieldStep{name}
 	}
+	return MapKeyStep{name}
 }
 
-func slicesOfComparable(v reflect.Value, len int) ([]interface{}, reflect.Type, bool) {
-	var typ reflect.Type
-	s := make([]interface{}, len)
-	for i := 0; i < len; i++ {
-		cVal := v.Index(i)
-		cdVal := dereference(cVal)
-		cTyp := cdVal.Type()
-		if !cTyp.Comparable() {
-			return nil, nil, false
-		}
-
-		if typ == nil {
-			typ = cTyp
-		} else if typ.Kind() != cTyp.Kind() {
-			return nil, nil, false
-		}
-		s[i] = cdVal.Interface()
+func sortedFieldNames(fields map[string]reflect.Value) []string {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
 	}
-	return s, typ, true
+	sort.Strings(names)
+	return names
 }
 
-func unorderedEq(a, b []interface{}) bool {
-	for _, expectedItem := range a {
-		found := false
-		for _, sliceItem := range b {
-			if sliceItem == expectedItem {
-				found = true
-				break
-			}
-		}
-		if !found {
-			return false
-		}
+func isNil(v reflect.Value, k reflect.Kind) bool {
+	switch k {
+	case reflect.Chan, reflect.Func, reflect.Map, reflect.Ptr, reflect.UnsafePointer, reflect.Interface, reflect.Slice:
+		return v.IsNil()
+	default:
+		return false
 	}
-	return true
-}
-
-type SimilarMatcher struct {
-	Name    string
-	Matches func(interface{}) bool
 }
 
-func Any() SimilarMatcher {
-	return SimilarMatcher{
-		Name: "Any",
-		Matches: func(i interface{}) bool {
-			return true
-		},
+// nolint:gocognit,gocyclo,nestif
+func findSimilarityDiffs(currentPath Path, va, vb reflect.Value, diffs *[]Diff,
+	ctx *similarContext) {
+	ctx.compared++
+	m, isMatcher, err := ctx.matcherOf(vb)
+	if err != nil {
+		*diffs = append(*diffs, newDiff(currentPath, PlaceholderDiff{dereference(vb).String(), err}))
+		return
 	}
-}
-
-func Matcher(name string, matches func(interface{}) bool) SimilarMatcher {
-	return SimilarMatcher{
-		Name:    name,
-		Matches: matches,
+	if isMatcher {
+		checkSimilarMatcher(currentPath, va, m, diffs, ctx)
+		return
 	}
-}
 
-type MatcherDiff struct {
-	Name  string
-	Value interface{}
-}
-
-func (fd MatcherDiff) Error() string {
-	return fmt.Sprintf("Matcher %s failed for value : %v", fd.Name, fd.Value)
-}
-
-// nolint:gocognit,gocyclo,nestif
-func findSimilarityDiffs(currentPath []string, va, vb reflect.Value, diffs *[]Diff,
-	visited map[similarVisit]bool, checkUnordered bool) {
 	if !va.IsValid() || !vb.IsValid() {
 		*diffs = append(*diffs, newDiff(currentPath, InvalidDiff{va.IsValid(), vb.IsValid()}))
 		return
 	}
 
-	if checkSimilarVisited(va, vb, visited) {
+	if checkSimilarVisited(va, vb, ctx.visited) {
 		return
 	}
 
@@ -177,20 +168,9 @@ func findSimilarityDiffs(currentPath []string, va, vb reflect.Value, diffs *[]Di
 
 	ka, kb := va.Kind(), vb.Kind()
 
-	if kb == reflect.Struct {
-		i := vb.Interface()
-		if m, ok := i.(SimilarMatcher); ok {
-			aValue := va.Interface()
-			if !m.Matches(aValue) {
-				*diffs = append(*diffs, newDiff(currentPath, MatcherDiff{m.Name, aValue}))
-			}
-			return
-		}
-	}
-
 	// Check func
 	if ka == reflect.Func {
-		*diffs = append(*diffs, newDiff(currentPath, FuncDiff{va.Interface(), vb.Interface()}))
+		*diffs = append(*diffs, newDiff(currentPath, FuncDiff{interfaceOf(va), interfaceOf(vb)}))
 		return
 	}
 
@@ -206,31 +186,29 @@ func findSimilarityDiffs(currentPath []string, va, vb reflect.Value, diffs *[]Di
 	lenB, bIsIndexed := isIndexed(vb, kb)
 
 	if (aIsIndexed || aIsNil) && (bIsIndexed || bIsNil) {
+		if ctx.subsetArrays {
+			checkSubsetSimilarity(currentPath, va, vb, lenA, lenB, diffs, ctx)
+			return
+		}
 		lenDiff := lenA - lenB
 		if lenDiff != 0 {
-			*diffs = append(*diffs, newDiff(currentPath, LenDiff{CommonDiff{va.Interface(), vb.Interface()}, lenDiff}))
+			if ctx.isUnorderedAt(currentPath) || !checkSimilarEditScript(currentPath, va, vb, lenA, lenB, diffs, ctx) {
+				*diffs = append(*diffs, newDiff(currentPath, LenDiff{CommonDiff{interfaceOf(va), interfaceOf(vb)}, lenDiff}))
+			}
 			return
 		}
-		if checkUnordered {
-			scA, typA, isSCA := slicesOfComparable(va, lenA)
-			scB, typB, isSCB := slicesOfComparable(vb, lenB)
-			if isSCA && isSCB && typA == typB {
-				if !unorderedEq(scA, scB) {
-					*diffs = append(*diffs, newDiff(currentPath, CommonDiff{scA, scB}))
-				}
-				// If slice of comparable check unordered
-				return
-			}
+		if ctx.isUnorderedAt(currentPath) {
+			checkUnorderedSimilarity(currentPath, va, vb, lenA, diffs, ctx)
+			return
 		}
 		for i := 0; i < lenA; i++ {
-			iKey := fmt.Sprintf("[%d]", i)
-			findSimilarityDiffs(append(currentPath, iKey), va.Index(i), vb.Index(i), diffs, visited, checkUnordered)
+			findSimilarityDiffs(append(currentPath, IndexStep{i}), va.Index(i), vb.Index(i), diffs, ctx)
 		}
 		return
 	}
 
 	if aIsIndexed != bIsIndexed {
-		*diffs = append(*diffs, newDiff(currentPath, TypeDiff{va.Interface(), vb.Interface()}))
+		*diffs = append(*diffs, newDiff(currentPath, TypeDiff{interfaceOf(va), interfaceOf(vb)}))
 		return
 	}
 
@@ -239,19 +217,23 @@ func findSimilarityDiffs(currentPath []string, va, vb reflect.Value, diffs *[]Di
 	bFields, bIsFielded := isFielded(vb, kb)
 
 	if (aIsFielded || aIsNil) && (bIsFielded || bIsNil) {
-		for k, aValue := range aFields {
-			fieldName := fmt.Sprintf("[%v]", k)
+		for _, k := range sortedFieldNames(aFields) {
+			aValue := aFields[k]
+			fieldName := fieldStep(k, ka)
 
 			if bValue, exists := bFields[k]; !exists {
+				if ctx.subset {
+					continue
+				}
 				*diffs = append(*diffs, newDiff(append(currentPath, fieldName),
 					KeyNotFoundDiff{Key: fmt.Sprintf("%v", k), A: true, B: false}))
 			} else {
-				findSimilarityDiffs(append(currentPath, fieldName), aValue, bValue, diffs, visited, checkUnordered)
+				findSimilarityDiffs(append(currentPath, fieldName), aValue, bValue, diffs, ctx)
 			}
 		}
-		for k := range bFields {
-			fieldName := fmt.Sprintf("[%v]", k)
-			if _, exists := aFields[k]; !exists {
+		for _, k := range sortedFieldNames(bFields) {
+			fieldName := fieldStep(k, kb)
+			if _, exists := aFields[k]; !exists && !ctx.isOptional(bFields[k]) {
 				*diffs = append(*diffs, newDiff(append(currentPath, fieldName),
 					KeyNotFoundDiff{Key: fmt.Sprintf("%v", k), A: false, B: true}))
 			}
@@ -260,12 +242,40 @@ func findSimilarityDiffs(currentPath []string, va, vb reflect.Value, diffs *[]Di
 	}
 
 	if aIsFielded != bIsFielded {
-		*diffs = append(*diffs, newDiff(currentPath, TypeDiff{va.Interface(), vb.Interface()}))
+		*diffs = append(*diffs, newDiff(currentPath, TypeDiff{interfaceOf(va), interfaceOf(vb)}))
 		return
 	}
 
 	// Check simple types
-	checkSimpleTypes(currentPath, va, vb, ka, kb, diffs, visited, checkUnordered)
+	checkSimpleTypes(currentPath, va, vb, ka, kb, diffs, ctx)
+}
+
+// checkSimilarEditScript reports the edit script of two indexed values of different lengths (see editScript) :
+// modified elements are compared, others are reported as DeletedDiff or InsertedDiff.
+// It returns false when the script is too costly.
+func checkSimilarEditScript(currentPath Path, va, vb reflect.Value, lenA, lenB int, diffs *[]Diff,
+	ctx *similarContext) bool {
+	trials := make(map[[2]int]*similarContext)
+	ops, ok := editScript(lenA, lenB, func(i, j int) (bool, int) {
+		trial := ctx.trial()
+		d := make([]Diff, 0)
+		findSimilarityDiffs(append(currentPath, IndexStep{i}), va.Index(i), vb.Index(j), &d, trial)
+		trials[[2]int{i, j}] = trial
+		return len(d) == 0, trial.compared
+	})
+	if !ok {
+		return false
+	}
+	editHunks(ops, func(i, j int) {
+		ctx.commit(trials[[2]int{i, j}])
+	}, func(i, j int) {
+		findSimilarityDiffs(append(currentPath, IndexStep{i}), va.Index(i), vb.Index(j), diffs, ctx)
+	}, func(i int) {
+		*diffs = append(*diffs, newDiff(append(currentPath, IndexStep{i}), DeletedDiff{interfaceOf(va.Index(i))}))
+	}, func(j int) {
+		*diffs = append(*diffs, newDiff(append(currentPath, IndexStep{j}), InsertedDiff{interfaceOf(vb.Index(j))}))
+	})
+	return true
 }
 
 func asNumeric(v reflect.Value, k reflect.Kind) (float64, bool) {
@@ -281,8 +291,8 @@ func asNumeric(v reflect.Value, k reflect.Kind) (float64, bool) {
 	}
 }
 
-func checkSimpleTypes(currentPath []string, va, vb reflect.Value,
-	ka reflect.Kind, kb reflect.Kind, diffs *[]Diff, visited map[similarVisit]bool, checkUnordered bool) {
+func checkSimpleTypes(currentPath Path, va, vb reflect.Value,
+	ka reflect.Kind, kb reflect.Kind, diffs *[]Diff, ctx *similarContext) {
 	na, aIsNumeric := asNumeric(va, ka)
 	nb, bIsNumeric := asNumeric(vb, kb)
 
@@ -293,9 +303,14 @@ func checkSimpleTypes(currentPath []string, va, vb reflect.Value,
 		return
 	}
 
+	if ka == reflect.Map && kb == reflect.Map {
+		checkSimilarMaps(currentPath, va, vb, diffs, ctx)
+		return
+	}
+
 	ta, tb := va.Type(), vb.Type()
 	if ta != tb {
-		*diffs = append(*diffs, newDiff(currentPath, TypeDiff{va.Interface(), vb.Interface()}))
+		*diffs = append(*diffs, newDiff(currentPath, TypeDiff{interfaceOf(va), interfaceOf(vb)}))
 		return
 	}
 	switch ka {
@@ -303,43 +318,74 @@ func checkSimpleTypes(currentPath []string, va, vb reflect.Value,
 		simpleEqDiff(va.Bool(), vb.Bool(), currentPath, diffs)
 	case reflect.Complex64, reflect.Complex128:
 		simpleEqDiff(va.Complex(), vb.Complex(), currentPath, diffs)
-	case reflect.UnsafePointer:
-		simpleEqDiff(va.Interface(), vb.Interface(), currentPath, diffs)
 	case reflect.String:
 		simpleEqDiff(va.String(), vb.String(), currentPath, diffs)
-	case reflect.Chan:
-		simpleEqDiff(va.Interface(), vb.Interface(), currentPath, diffs)
-	case reflect.Map:
-		if ta.Key().Kind() == reflect.String {
-			panic(fmt.Errorf("should not have a string keyed map")) //nolint:goerr113
-		}
-		checkSimilarMaps(currentPath, va, vb, diffs, visited, checkUnordered)
 	default:
-		panic(fmt.Errorf("should not have kind : %v", ka)) //nolint:goerr113
+		if va.CanInterface() && vb.CanInterface() && ta.Comparable() {
+			simpleEqDiff(va.Interface(), vb.Interface(), currentPath, diffs)
+			return
+		}
+		*diffs = append(*diffs, newDiff(currentPath, UnsupportedDiff{CommonDiff{interfaceOf(va), interfaceOf(vb)}, ka}))
+	}
+}
+
+// interfaceOf returns v as an interface{} or its "%v" representation when it can't be (unexported fields).
+func interfaceOf(v reflect.Value) interface{} {
+	if !v.IsValid() {
+		return nil
+	}
+	if v.CanInterface() {
+		return v.Interface()
 	}
+	return fmt.Sprintf("%v", v)
 }
 
-func checkSimilarMaps(currentPath []string, va, vb reflect.Value, diffs *[]Diff,
-	visited map[similarVisit]bool, checkUnordered bool) {
+// mapKey returns the m key matching k by value (see Compare), m and k types may differ.
+func mapKey(m reflect.Value, k reflect.Value) (reflect.Value, bool) {
+	if k.Type().AssignableTo(m.Type().Key()) {
+		if m.MapIndex(k).IsValid() {
+			return k, true
+		}
+	}
+	for _, candidate := range m.MapKeys() {
+		if compareValues(k, candidate) == 0 && kindRank(interfaceDereference(k)) != otherRank {
+			return candidate, true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+func checkSimilarMaps(currentPath Path, va, vb reflect.Value, diffs *[]Diff,
+	ctx *similarContext) {
+	if ctx.subset {
+		for _, k := range sortedMapKeys(vb) {
+			fieldName := MapKeyStep{interfaceOf(k)}
+			if aKey, found := mapKey(va, k); found {
+				findSimilarityDiffs(append(currentPath, fieldName), va.MapIndex(aKey), vb.MapIndex(k), diffs, ctx)
+			} else {
+				*diffs = append(*diffs, newDiff(append(currentPath, fieldName),
+					KeyNotFoundDiff{Key: fmt.Sprintf("%v", k), A: false, B: true}))
+			}
+		}
+		return
+	}
 	lenVa := va.Len()
 	if lenDiff := lenVa - vb.Len(); lenDiff != 0 {
-		*diffs = append(*diffs, newDiff(currentPath, LenDiff{CommonDiff{va.Interface(), vb.Interface()}, lenDiff}))
+		*diffs = append(*diffs, newDiff(currentPath, LenDiff{CommonDiff{interfaceOf(va), interfaceOf(vb)}, lenDiff}))
 		return
 	}
-	for _, k := range va.MapKeys() {
-		fieldName := fmt.Sprintf("[%v]", k)
-		bValue := vb.MapIndex(k)
-		if !bValue.IsValid() || bValue.IsZero() {
+	for _, k := range sortedMapKeys(va) {
+		fieldName := MapKeyStep{interfaceOf(k)}
+		if bKey, found := mapKey(vb, k); found {
+			findSimilarityDiffs(append(currentPath, fieldName), va.MapIndex(k), vb.MapIndex(bKey), diffs, ctx)
+		} else {
 			*diffs = append(*diffs, newDiff(append(currentPath, fieldName),
 				KeyNotFoundDiff{Key: fmt.Sprintf("%v", k), A: true, B: false}))
-		} else {
-			findSimilarityDiffs(append(currentPath, fieldName), va.MapIndex(k), bValue, diffs, visited, checkUnordered)
 		}
 	}
-	for _, k := range vb.MapKeys() {
-		fieldName := fmt.Sprintf("[%v]", k)
-		aValue := va.MapIndex(k)
-		if !aValue.IsValid() || aValue.IsZero() {
+	for _, k := range sortedMapKeys(vb) {
+		fieldName := MapKeyStep{interfaceOf(k)}
+		if _, found := mapKey(va, k); !found {
 			*diffs = append(*diffs, newDiff(append(currentPath, fieldName),
 				KeyNotFoundDiff{Key: fmt.Sprintf("%v", k), A: false, B: true}))
 		}
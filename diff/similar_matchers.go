@@ -0,0 +1,234 @@
+package diff
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"time"
+)
+
+// SimilarMatcher is a wildcard usable anywhere in a Similar expectation (b side) : the compared value is
+// checked by the matcher instead of being compared structurally.
+//
+// Matches is the matcher predicate. Check, when set, is used instead of Matches and also returns a failure log
+// reported in MatcherDiff. Optional matchers also accept absent keys (see Optional).
+type SimilarMatcher struct {
+	Name     string
+	Matches  func(interface{}) bool
+	Check    func(interface{}) (bool, string)
+	Optional bool
+
+	capture   func(interface{})
+	captureAs string
+}
+
+func (m SimilarMatcher) match(v interface{}) (bool, string) {
+	if m.Check != nil {
+		return m.Check(v)
+	}
+	return m.Matches(v), ""
+}
+
+// MatcherDiff reports that a SimilarMatcher failed for Value, Log being the optional matcher failure log.
+type MatcherDiff struct {
+	Name  string
+	Value interface{}
+	Log   string
+}
+
+func (fd MatcherDiff) Error() string {
+	if fd.Log != "" {
+		return fmt.Sprintf("Matcher %s failed for value : %v\n%s", fd.Name, fd.Value, fd.Log)
+	}
+	return fmt.Sprintf("Matcher %s failed for value : %v", fd.Name, fd.Value)
+}
+
+func similarMatcherOf(v reflect.Value) (SimilarMatcher, bool) {
+	v = dereference(v)
+	if v.Kind() != reflect.Struct || !v.CanInterface() {
+		return SimilarMatcher{}, false
+	}
+	m, ok := v.Interface().(SimilarMatcher)
+	return m, ok
+}
+
+// matcherOf returns the SimilarMatcher of an expectation value : a SimilarMatcher or a placeholder string
+// when placeholders are enabled (see WithPlaceholders).
+func (ctx *similarContext) matcherOf(v reflect.Value) (SimilarMatcher, bool, error) {
+	if m, ok := similarMatcherOf(v); ok {
+		return m, true, nil
+	}
+	v = dereference(v)
+	if ctx.placeholders == nil || v.Kind() != reflect.String {
+		return SimilarMatcher{}, false, nil
+	}
+	return ctx.placeholders.resolve(v.String())
+}
+
+func (ctx *similarContext) isOptional(v reflect.Value) bool {
+	m, ok, _ := ctx.matcherOf(v)
+	return ok && m.Optional
+}
+
+func checkSimilarMatcher(currentPath Path, va reflect.Value, m SimilarMatcher, diffs *[]Diff,
+	ctx *similarContext) {
+	aValue := interfaceOf(dereference(va))
+	matches, log := m.match(aValue)
+	if !matches {
+		*diffs = append(*diffs, newDiff(currentPath, MatcherDiff{Name: m.Name, Value: aValue, Log: log}))
+		return
+	}
+	if m.capture != nil {
+		ctx.capture(func() { m.capture(aValue) })
+	}
+	if m.captureAs != "" && ctx.captured != nil {
+		captured := ctx.captured
+		ctx.capture(func() { captured.set(m.captureAs, aValue) })
+	}
+}
+
+// Any matcher matches any value.
+func Any() SimilarMatcher {
+	return SimilarMatcher{
+		Name: "Any",
+		Matches: func(i interface{}) bool {
+			return true
+		},
+	}
+}
+
+// Matcher function creates a named SimilarMatcher from a predicate.
+func Matcher(name string, matches func(interface{}) bool) SimilarMatcher {
+	return SimilarMatcher{
+		Name:    name,
+		Matches: matches,
+	}
+}
+
+// CheckMatcher function creates a named SimilarMatcher from a check returning a failure log.
+func CheckMatcher(name string, check func(interface{}) (bool, string)) SimilarMatcher {
+	return SimilarMatcher{
+		Name:  name,
+		Check: check,
+	}
+}
+
+// AnyOfType matcher matches any value having the same type as t (eg. AnyOfType(0) matches any int).
+func AnyOfType(t interface{}) SimilarMatcher {
+	typ := reflect.TypeOf(t)
+	return CheckMatcher(fmt.Sprintf("AnyOfType(%v)", typ), func(v interface{}) (bool, string) {
+		if vt := reflect.TypeOf(v); vt != typ {
+			return false, fmt.Sprintf("value type is %v", vt)
+		}
+		return true, ""
+	})
+}
+
+// NotNil matcher matches any value but nil ones (including nil pointers, maps, slices...).
+func NotNil() SimilarMatcher {
+	return Matcher("NotNil", func(v interface{}) bool {
+		if v == nil {
+			return false
+		}
+		rv := reflect.ValueOf(v)
+		return !isNil(rv, rv.Kind())
+	})
+}
+
+// Regexp matcher matches strings matching the re regular expression (panics if re is invalid).
+func Regexp(re string) SimilarMatcher {
+	r := regexp.MustCompile(re)
+	return stringMatcher(fmt.Sprintf("Regexp(%s)", re), r.MatchString)
+}
+
+var uuidRe = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// UUID matcher matches strings in the canonical UUID form (eg. "123e4567-e89b-12d3-a456-426614174000").
+func UUID() SimilarMatcher {
+	return stringMatcher("UUID", uuidRe.MatchString)
+}
+
+// RFC3339 matcher matches strings being RFC 3339 timestamps, with or without fractional seconds.
+func RFC3339() SimilarMatcher {
+	return stringMatcher("RFC3339", func(s string) bool {
+		_, err := time.Parse(time.RFC3339Nano, s)
+		return err == nil
+	})
+}
+
+func stringMatcher(name string, matches func(string) bool) SimilarMatcher {
+	return CheckMatcher(name, func(v interface{}) (bool, string) {
+		rv := reflect.ValueOf(v)
+		if rv.Kind() != reflect.String {
+			return false, "value is not a string"
+		}
+		return matches(rv.String()), ""
+	})
+}
+
+// Between matcher matches values between min and max (inclusive) : numbers whatever their kind,
+// strings or time.Time values. Other values are ordered as by Compare.
+func Between(min, max interface{}) SimilarMatcher {
+	return CheckMatcher(fmt.Sprintf("Between(%v, %v)", min, max), func(v interface{}) (bool, string) {
+		if t, ok := v.(time.Time); ok {
+			tMin, okMin := min.(time.Time)
+			tMax, okMax := max.(time.Time)
+			if !okMin || !okMax {
+				return false, "time value cannot be compared to non time bounds"
+			}
+			return !t.Before(tMin) && !t.After(tMax), ""
+		}
+		return Compare(v, min) >= 0 && Compare(v, max) <= 0, ""
+	})
+}
+
+// Len matcher matches strings, slices, arrays, maps and channels of length n.
+func Len(n int) SimilarMatcher {
+	return CheckMatcher(fmt.Sprintf("Len(%d)", n), func(v interface{}) (bool, string) {
+		rv := dereference(reflect.ValueOf(v))
+		switch rv.Kind() {
+		case reflect.String, reflect.Slice, reflect.Array, reflect.Map, reflect.Chan:
+			if l := rv.Len(); l != n {
+				return false, fmt.Sprintf("value length is %d", l)
+			}
+			return true, ""
+		default:
+			return false, "value has no length"
+		}
+	})
+}
+
+// Optional matcher accepts absent keys (on maps and structs), m being applied when the key is present.
+func Optional(m SimilarMatcher) SimilarMatcher {
+	m.Name = fmt.Sprintf("Optional(%s)", m.Name)
+	m.Optional = true
+	return m
+}
+
+// Capture matcher matches any value assignable to target (a non nil pointer) and stores it in target.
+// When several candidates are tried (see Unordered and SubsetArrays options) only the retained pairs are captured.
+func Capture(target interface{}) SimilarMatcher {
+	rt := reflect.ValueOf(target)
+	if rt.Kind() != reflect.Ptr || rt.IsNil() {
+		panic(fmt.Sprintf("[type error] Capture target should be a non nil pointer, got %T", target))
+	}
+	elem := rt.Elem()
+	m := CheckMatcher(fmt.Sprintf("Capture(%v)", elem.Type()), func(v interface{}) (bool, string) {
+		if v == nil {
+			if isNil(reflect.Zero(elem.Type()), elem.Kind()) {
+				return true, ""
+			}
+		} else if reflect.TypeOf(v).AssignableTo(elem.Type()) {
+			return true, ""
+		}
+		return false, fmt.Sprintf("value of type %T cannot be captured into %v", v, elem.Type())
+	})
+	m.capture = func(v interface{}) {
+		if v == nil {
+			elem.Set(reflect.Zero(elem.Type()))
+			return
+		}
+		elem.Set(reflect.ValueOf(v))
+	}
+	return m
+}
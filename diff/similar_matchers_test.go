@@ -0,0 +1,150 @@
+package diff_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/elethoughts-code/goasserts/diff"
+)
+
+func Test_SimilarMatchers_should_match(t *testing.T) {
+	// Given
+	var nilPtr *int
+	now := time.Now()
+	testCases := []struct {
+		a       interface{}
+		b       diff.SimilarMatcher
+		matches bool
+	}{
+		{a: 1, b: diff.AnyOfType(0), matches: true},
+		{a: "1", b: diff.AnyOfType(0), matches: false},
+		{a: int64(1), b: diff.AnyOfType(0), matches: false},
+		{a: 1, b: diff.NotNil(), matches: true},
+		{a: nil, b: diff.NotNil(), matches: false},
+		{a: nilPtr, b: diff.NotNil(), matches: false},
+		{a: "abc-123", b: diff.Regexp(`^[a-z]+-\d+$`), matches: true},
+		{a: "abc", b: diff.Regexp(`^\d+$`), matches: false},
+		{a: 123, b: diff.Regexp(`^\d+$`), matches: false},
+		{a: "123e4567-e89b-12d3-a456-426614174000", b: diff.UUID(), matches: true},
+		{a: "123e4567-e89b-12d3-a456", b: diff.UUID(), matches: false},
+		{a: "2021-03-04T10:20:30Z", b: diff.RFC3339(), matches: true},
+		{a: "2021-03-04T10:20:30.123+01:00", b: diff.RFC3339(), matches: true},
+		{a: "2021-03-04 10:20:30", b: diff.RFC3339(), matches: false},
+		{a: 5, b: diff.Between(1, 10), matches: true},
+		{a: 1.5, b: diff.Between(1, 2), matches: true},
+		{a: uint8(10), b: diff.Between(1, 10), matches: true},
+		{a: 11, b: diff.Between(1, 10), matches: false},
+		{a: "5", b: diff.Between(1, 10), matches: false},
+		{a: now, b: diff.Between(now.Add(-time.Second), now.Add(time.Second)), matches: true},
+		{a: now, b: diff.Between(now.Add(time.Second), now.Add(2*time.Second)), matches: false},
+		{a: now, b: diff.Between(1, 2), matches: false},
+		{a: []int{1, 2}, b: diff.Len(2), matches: true},
+		{a: "abc", b: diff.Len(3), matches: true},
+		{a: map[string]int{"a": 1}, b: diff.Len(2), matches: false},
+		{a: 1, b: diff.Len(1), matches: false},
+	}
+
+	for i, tc := range testCases {
+		// When
+		result := diff.SimilarWith(tc.a, tc.b)
+		// Then
+		if (len(result) == 0) != tc.matches {
+			t.Errorf("%d : %s matches %v, unexpected diffs %v", i, tc.b.Name, tc.a, result)
+		}
+	}
+}
+
+func Test_SimilarMatchers_should_report_logs(t *testing.T) {
+	// When
+	result := diff.SimilarWith(map[string]interface{}{"A": []int{1}}, map[string]interface{}{"A": diff.Len(2)})
+	// Then
+	expected := diffs(d(path("[A]"), diff.MatcherDiff{Name: "Len(2)", Value: []int{1}, Log: "value length is 1"}))
+	if !reflect.DeepEqual(withoutSteps(result), expected) {
+		t.Fatalf("unexpected diffs %v", result)
+	}
+	if result[0].Value.Error() != "Matcher Len(2) failed for value : [1]\nvalue length is 1" {
+		t.Errorf("unexpected message %s", result[0].Value.Error())
+	}
+}
+
+func Test_Optional_matcher(t *testing.T) {
+	// Given
+	type sample struct {
+		A string
+	}
+	b := map[string]interface{}{"A": "a", "B": diff.Optional(diff.AnyOfType(0))}
+
+	// When
+	absent := diff.SimilarWith(sample{A: "a"}, b)
+	present := diff.SimilarWith(map[string]interface{}{"A": "a", "B": 2}, b)
+	invalid := diff.SimilarWith(map[string]interface{}{"A": "a", "B": "2"}, b)
+
+	// Then
+	if len(absent) != 0 || len(present) != 0 {
+		t.Errorf("unexpected diffs %v %v", absent, present)
+	}
+	expected := diffs(d(path("[B]"), diff.MatcherDiff{Name: "Optional(AnyOfType(int))", Value: "2",
+		Log: "value type is string"}))
+	if !reflect.DeepEqual(withoutSteps(invalid), expected) {
+		t.Errorf("unexpected diffs %v", invalid)
+	}
+}
+
+func Test_Capture_matcher(t *testing.T) {
+	// Given
+	var id string
+	var count float64
+	var tags interface{}
+
+	// When
+	result := diff.SimilarWith(
+		map[string]interface{}{"id": "x-1", "count": 3.0, "tags": []string{"a"}},
+		map[string]interface{}{"id": diff.Capture(&id), "count": diff.Capture(&count), "tags": diff.Capture(&tags)})
+
+	// Then
+	if len(result) != 0 || id != "x-1" || count != 3 || !reflect.DeepEqual(tags, []string{"a"}) {
+		t.Errorf("unexpected capture %v %v %v %v", result, id, count, tags)
+	}
+}
+
+func Test_Capture_matcher_type_mismatch(t *testing.T) {
+	// Given
+	id := "unchanged"
+
+	// When
+	result := diff.SimilarWith(map[string]interface{}{"id": 1}, map[string]interface{}{"id": diff.Capture(&id)})
+
+	// Then
+	if len(result) != 1 || id != "unchanged" {
+		t.Errorf("unexpected capture %v %v", result, id)
+	}
+}
+
+func Test_Capture_matcher_unordered_retained_pairs(t *testing.T) {
+	// Given
+	var name string
+	a := []map[string]interface{}{{"name": "bob", "role": "user"}, {"name": "alice", "role": "admin"}}
+	b := []interface{}{
+		map[string]interface{}{"name": diff.Any(), "role": "user"},
+		map[string]interface{}{"name": diff.Capture(&name), "role": "admin"},
+	}
+
+	// When
+	result := diff.SimilarWith(a, b, diff.Unordered())
+	subsetResult := diff.SimilarSubset(a, b[1:], diff.SubsetArrays())
+
+	// Then
+	if len(result) != 0 || len(subsetResult) != 0 || name != "alice" {
+		t.Errorf("unexpected capture %v %v %v", result, subsetResult, name)
+	}
+}
+
+func Test_Capture_should_panic_on_non_pointer(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Capture should panic")
+		}
+	}()
+	diff.Capture("not a pointer")
+}
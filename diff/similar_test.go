@@ -74,28 +74,19 @@ func Test_Similar_simple_one_level_differences(t *testing.T) {
 			result: rootLevelDiffs(diff.CommonDiff{A: "this is a string", B: "this is another string"}),
 		},
 		{
-			a: [3]string{"a", "b", "c"},
-			b: [2]string{"a", "b"},
-			result: rootLevelDiffs(diff.LenDiff{
-				CommonDiff: diff.CommonDiff{A: [3]string{"a", "b", "c"}, B: [2]string{"a", "b"}},
-				Value:      1,
-			}),
+			a:      [3]string{"a", "b", "c"},
+			b:      [2]string{"a", "b"},
+			result: []diff.Diff{{Path: []string{"[2]"}, Value: diff.DeletedDiff{Value: "c"}}},
 		},
 		{
-			a: []string{"a", "b", "c"},
-			b: [2]string{"a", "b"},
-			result: rootLevelDiffs(diff.LenDiff{
-				CommonDiff: diff.CommonDiff{A: []string{"a", "b", "c"}, B: [2]string{"a", "b"}},
-				Value:      1,
-			}),
+			a:      []string{"a", "b", "c"},
+			b:      [2]string{"a", "b"},
+			result: []diff.Diff{{Path: []string{"[2]"}, Value: diff.DeletedDiff{Value: "c"}}},
 		},
 		{
-			a: []string{"a", "b"},
-			b: []string{"a", "b", "c"},
-			result: rootLevelDiffs(diff.LenDiff{
-				CommonDiff: diff.CommonDiff{A: []string{"a", "b"}, B: []string{"a", "b", "c"}},
-				Value:      -1,
-			}),
+			a:      []string{"a", "b"},
+			b:      []string{"a", "b", "c"},
+			result: []diff.Diff{{Path: []string{"[2]"}, Value: diff.InsertedDiff{Value: "c"}}},
 		},
 		{
 			a: map[int]string{1: "a", 2: "b", 3: "c"},
@@ -174,7 +165,7 @@ func Test_Similar_simple_one_level_differences(t *testing.T) {
 		// When
 		d := diff.Similar(tc.a, tc.b, false)
 		// Then
-		if !reflect.DeepEqual(d, tc.result) {
+		if !reflect.DeepEqual(withoutSteps(d), tc.result) {
 			t.Logf("%v", i)
 			t.Fail()
 		}
@@ -332,7 +323,7 @@ func Test_Similar_simple_second_level_differences(t *testing.T) {
 		// When
 		d := diff.Similar(tc.a, tc.b, false)
 		// Then
-		if !reflect.DeepEqual(d, tc.result) {
+		if !reflect.DeepEqual(withoutSteps(d), tc.result) {
 			t.Fail()
 		}
 	}
@@ -521,12 +512,24 @@ func Test_Similar_multi_level_differences(t *testing.T) {
 		// When
 		d := diff.Similar(tc.a, tc.b, false)
 		// Then
-		if !reflect.DeepEqual(diffMap(d), diffMap(tc.result)) {
+		if !reflect.DeepEqual(diffMap(withoutSteps(d)), diffMap(tc.result)) {
 			t.Fail()
 		}
 	}
 }
 
+// withoutSteps drops the diffs typed paths, expectations being written with Path tokens.
+func withoutSteps(diffs []diff.Diff) []diff.Diff {
+	if diffs == nil {
+		return nil
+	}
+	result := make([]diff.Diff, len(diffs))
+	for i, d := range diffs {
+		result[i] = diff.Diff{Path: d.Path, Value: d.Value}
+	}
+	return result
+}
+
 func diffMap(diffs []diff.Diff) map[string]diff.Diff {
 	m := make(map[string]diff.Diff)
 	for _, d := range diffs {
@@ -594,7 +597,7 @@ func Test_Similar_Unordered_simple_one_level_differences(t *testing.T) {
 		{
 			a:      []string{"a", "b", "c"},
 			b:      []string{"a", "d", "c"},
-			result: rootLevelDiffs(diff.CommonDiff{A: []interface{}{"a", "b", "c"}, B: []interface{}{"a", "d", "c"}}),
+			result: diffs(d(path("[1]"), diff.CommonDiff{A: "b", B: "d"})),
 		},
 		{
 			a: []string{"a", "b", "c"},
@@ -606,20 +609,14 @@ func Test_Similar_Unordered_simple_one_level_differences(t *testing.T) {
 		},
 
 		{
-			a: []ComparableStr{{"a"}, {"b"}, {"c"}},
-			b: []ComparableStr{{"a"}, {"b"}, {"d"}},
-			result: rootLevelDiffs(diff.CommonDiff{
-				A: []interface{}{ComparableStr{"a"}, ComparableStr{"b"}, ComparableStr{"c"}},
-				B: []interface{}{ComparableStr{"a"}, ComparableStr{"b"}, ComparableStr{"d"}},
-			}),
+			a:      []ComparableStr{{"a"}, {"b"}, {"c"}},
+			b:      []ComparableStr{{"a"}, {"b"}, {"d"}},
+			result: diffs(d(path("[2]", "[A]"), diff.CommonDiff{A: "c", B: "d"})),
 		},
 		{
-			a: []ComparableStr{{"a"}, {"b"}, {"c"}},
-			b: []interface{}{ComparableStr{"a"}, &ComparableStr{"b"}, ComparableStr{"d"}},
-			result: rootLevelDiffs(diff.CommonDiff{
-				A: []interface{}{ComparableStr{"a"}, ComparableStr{"b"}, ComparableStr{"c"}},
-				B: []interface{}{ComparableStr{"a"}, ComparableStr{"b"}, ComparableStr{"d"}},
-			}),
+			a:      []ComparableStr{{"a"}, {"b"}, {"c"}},
+			b:      []interface{}{ComparableStr{"a"}, &ComparableStr{"b"}, ComparableStr{"d"}},
+			result: diffs(d(path("[2]", "[A]"), diff.CommonDiff{A: "c", B: "d"})),
 		},
 		{
 			a:      []ComparableStr{{"a"}, {"b"}, {"c"}},
@@ -631,6 +628,11 @@ func Test_Similar_Unordered_simple_one_level_differences(t *testing.T) {
 			b:      []interface{}{ComparableStr{"a"}, "b", ComparableStr{"c"}},
 			result: diffs(d(path("[1]"), diff.TypeDiff{A: ComparableStr{"b"}, B: "b"})),
 		},
+		{
+			a:      []int{1, 1, 2},
+			b:      []int{1, 2, 2},
+			result: diffs(d(path("[1]"), diff.CommonDiff{A: float64(1), B: float64(2)})),
+		},
 		{
 			a: SampleStruct{
 				A: 1,
@@ -782,7 +784,7 @@ func Test_Similar_Unordered_simple_one_level_differences(t *testing.T) {
 		// When
 		d := diff.Similar(tc.a, tc.b, true)
 		// Then
-		if !reflect.DeepEqual(diffMap(d), diffMap(tc.result)) {
+		if !reflect.DeepEqual(diffMap(withoutSteps(d)), diffMap(tc.result)) {
 			t.Fail()
 		}
 	}
@@ -964,8 +966,265 @@ func Test_Similar_simple_one_level_matcher_differences_functions(t *testing.T) {
 		// When
 		d := diff.Similar(tc.a, tc.b, true)
 		// Then
-		if !reflect.DeepEqual(diffMap(d), diffMap(tc.result)) {
+		if !reflect.DeepEqual(diffMap(withoutSteps(d)), diffMap(tc.result)) {
 			t.Fail()
 		}
 	}
 }
+
+func Test_Similar_Unordered_non_comparable_elements(t *testing.T) {
+	// Given
+	testCases := []struct {
+		a      interface{}
+		b      interface{}
+		opts   []diff.SimilarOption
+		result []diff.Diff
+	}{
+		{
+			a:      []map[string]interface{}{{"A": 1}, {"A": 2}, {"A": 3}},
+			b:      []map[string]interface{}{{"A": 3}, {"A": 1}, {"A": 2}},
+			opts:   []diff.SimilarOption{diff.Unordered()},
+			result: diffs(),
+		},
+		{
+			a:    []map[string]interface{}{{"A": 1}, {"A": 2}, {"A": 3}},
+			b:    []map[string]interface{}{{"A": 3}, {"A": 1}, {"A": 2}},
+			opts: []diff.SimilarOption{},
+			result: diffs(
+				d(path("[0]", "[A]"), diff.CommonDiff{A: float64(1), B: float64(3)}),
+				d(path("[1]", "[A]"), diff.CommonDiff{A: float64(2), B: float64(1)}),
+				d(path("[2]", "[A]"), diff.CommonDiff{A: float64(3), B: float64(2)}),
+			),
+		},
+		{
+			a:    []interface{}{[]int{1, 2}, map[string]interface{}{"A": "a", "B": "b"}},
+			b:    []interface{}{map[string]interface{}{"A": "a", "B": "c"}, []int{2, 1}},
+			opts: []diff.SimilarOption{diff.Unordered()},
+			result: diffs(
+				d(path("[1]", "[B]"), diff.CommonDiff{A: "b", B: "c"}),
+			),
+		},
+		{
+			a: map[string]interface{}{
+				"items": []interface{}{map[string]interface{}{"tags": []interface{}{"x", "y"}}},
+				"list":  []interface{}{1, 2},
+			},
+			b: map[string]interface{}{
+				"items": []interface{}{map[string]interface{}{"tags": []interface{}{"y", "x"}}},
+				"list":  []interface{}{2, 1},
+			},
+			opts: []diff.SimilarOption{diff.UnorderedAt("$.items[*].tags")},
+			result: diffs(
+				d(path("[list]", "[0]"), diff.CommonDiff{A: float64(1), B: float64(2)}),
+				d(path("[list]", "[1]"), diff.CommonDiff{A: float64(2), B: float64(1)}),
+			),
+		},
+		{
+			a: map[string]interface{}{
+				"items": []interface{}{map[string]interface{}{"id": 1}, map[string]interface{}{"id": 2}},
+			},
+			b: map[string]interface{}{
+				"items": []interface{}{map[string]interface{}{"id": 2}, map[string]interface{}{"id": 1}},
+			},
+			opts:   []diff.SimilarOption{diff.UnorderedAt("$['items']")},
+			result: diffs(),
+		},
+		{
+			a:      []string{"a", "a", "b"},
+			b:      []string{"b", "a", "b"},
+			opts:   []diff.SimilarOption{diff.Unordered()},
+			result: diffs(d(path("[1]"), diff.CommonDiff{A: "a", B: "b"})),
+		},
+		{
+			a:      map[string][]int{"ids": {1, 1, 2}},
+			b:      map[string][]int{"ids": {2, 1, 2}},
+			opts:   []diff.SimilarOption{diff.UnorderedAt("$.ids")},
+			result: diffs(d(path("[ids]", "[1]"), diff.CommonDiff{A: float64(1), B: float64(2)})),
+		},
+	}
+
+	for _, tc := range testCases {
+		// When
+		d := diff.SimilarWith(tc.a, tc.b, tc.opts...)
+		// Then
+		if !reflect.DeepEqual(diffMap(withoutSteps(d)), diffMap(tc.result)) {
+			t.Errorf("unexpected diffs %v", d)
+		}
+	}
+}
+
+func Test_Similar_interface_and_non_string_keyed_maps(t *testing.T) {
+	// Given
+	type config struct {
+		Name  string
+		Ports []int
+	}
+	testCases := []struct {
+		a      interface{}
+		b      interface{}
+		result []diff.Diff
+	}{
+		{
+			a:      map[interface{}]interface{}{"Name": "api", "Ports": []interface{}{80, 443}},
+			b:      config{Name: "api", Ports: []int{80, 443}},
+			result: diffs(),
+		},
+		{
+			a: map[interface{}]interface{}{"Name": "web", "Extra": true},
+			b: config{Name: "api"},
+			result: diffs(
+				d(path("[Extra]"), diff.KeyNotFoundDiff{Key: "Extra", A: true, B: false}),
+				d(path("[Name]"), diff.CommonDiff{A: "web", B: "api"}),
+				d(path("[Ports]"), diff.KeyNotFoundDiff{Key: "Ports", A: false, B: true}),
+			),
+		},
+		{
+			a: map[interface{}]interface{}{1: "a"},
+			b: config{},
+			result: rootLevelDiffs(diff.TypeDiff{
+				A: map[interface{}]interface{}{1: "a"},
+				B: config{},
+			}),
+		},
+		{
+			a:      map[int]string{1: "a", 2: ""},
+			b:      map[int64]string{1: "a", 2: ""},
+			result: diffs(),
+		},
+		{
+			a: map[interface{}]interface{}{1: "a", 2.5: "b"},
+			b: map[float64]string{1: "a", 3: "b"},
+			result: diffs(
+				d(path("[2.5]"), diff.KeyNotFoundDiff{Key: "2.5", A: true, B: false}),
+				d(path("[3]"), diff.KeyNotFoundDiff{Key: "3", A: false, B: true}),
+			),
+		},
+		{
+			a:      map[bool]int{true: 1},
+			b:      map[bool]int{true: 2},
+			result: diffs(d(path("[true]"), diff.CommonDiff{A: float64(1), B: float64(2)})),
+		},
+	}
+
+	for i, tc := range testCases {
+		// When
+		d := diff.Similar(tc.a, tc.b, false)
+		// Then
+		if !reflect.DeepEqual(diffMap(withoutSteps(d)), diffMap(tc.result)) {
+			t.Errorf("%d : unexpected diffs %v", i, d)
+		}
+	}
+}
+
+func Test_Similar_unexported_uncomparable_values(t *testing.T) {
+	// Given
+	type hidden struct {
+		c chan int
+	}
+
+	// When
+	d := diff.Similar(hidden{make(chan int)}, hidden{make(chan int)}, false)
+
+	// Then
+	if len(d) != 1 || d[0].Value.Error() != "values of kind chan cannot be compared" ||
+		!reflect.DeepEqual(d[0].Path, path("[c]")) {
+		t.Errorf("unexpected diffs %v", d)
+	}
+}
+
+func Test_SimilarSubset_differences(t *testing.T) {
+	// Given
+	type address struct {
+		City string
+		Zip  string
+	}
+	type person struct {
+		Name    string
+		Age     int
+		Address address
+		Tags    []string
+	}
+	bob := person{Name: "bob", Age: 32, Address: address{City: "Paris", Zip: "75001"}, Tags: []string{"a", "b", "c"}}
+	testCases := []struct {
+		a      interface{}
+		b      interface{}
+		opts   []diff.SimilarOption
+		result []diff.Diff
+	}{
+		{
+			a:      bob,
+			b:      map[string]interface{}{"Name": "bob", "Address": map[string]interface{}{"City": "Paris"}},
+			result: diffs(),
+		},
+		{
+			a: bob,
+			b: map[string]interface{}{"Name": "alice", "Address": map[string]interface{}{"Country": "FR"}},
+			result: diffs(
+				d(path("[Address]", "[Country]"), diff.KeyNotFoundDiff{Key: "Country", A: false, B: true}),
+				d(path("[Name]"), diff.CommonDiff{A: "bob", B: "alice"}),
+			),
+		},
+		{
+			a:      bob,
+			b:      map[string]interface{}{"Tags": []string{"a", "b"}},
+			result: diffs(d(path("[Tags]", "[2]"), diff.DeletedDiff{Value: "c"})),
+		},
+		{
+			a:      bob,
+			b:      map[string]interface{}{"Tags": []string{"c", "a"}},
+			opts:   []diff.SimilarOption{diff.SubsetArrays()},
+			result: diffs(),
+		},
+		{
+			a:    bob,
+			b:    map[string]interface{}{"Tags": []string{"c", "d", "e", "f"}},
+			opts: []diff.SimilarOption{diff.SubsetArrays()},
+			result: diffs(
+				d(path("[Tags]", "[0]"), diff.CommonDiff{A: "a", B: "d"}),
+				d(path("[Tags]", "[1]"), diff.CommonDiff{A: "b", B: "e"}),
+				d(path("[Tags]", "[3]"), diff.ElementNotFoundDiff{Value: "f"}),
+			),
+		},
+		{
+			a:    []person{bob, {Name: "alice"}},
+			b:    []map[string]interface{}{{"Name": "alice"}, {"Name": "carol"}},
+			opts: []diff.SimilarOption{diff.SubsetArrays()},
+			result: diffs(
+				d(path("[0]", "[Name]"), diff.CommonDiff{A: "bob", B: "carol"}),
+			),
+		},
+		{
+			a:      map[int]string{1: "a", 2: "b"},
+			b:      map[int]string{2: "b"},
+			result: diffs(),
+		},
+		{
+			a:      map[int]string{1: "a"},
+			b:      map[int]string{2: "b"},
+			result: diffs(d(path("[2]"), diff.KeyNotFoundDiff{Key: "2", A: false, B: true})),
+		},
+	}
+
+	for i, tc := range testCases {
+		// When
+		d := diff.SimilarSubset(tc.a, tc.b, tc.opts...)
+		// Then
+		if !reflect.DeepEqual(diffMap(withoutSteps(d)), diffMap(tc.result)) {
+			t.Errorf("%d : unexpected diffs %v", i, d)
+		}
+	}
+}
+
+func Test_SimilarSubset_should_not_write_caller_options(t *testing.T) {
+	// Given
+	opts := make([]diff.SimilarOption, 1, 2)
+	opts[0] = diff.SubsetArrays()
+
+	// When
+	d := diff.SimilarSubset([]int{1, 2}, []int{2}, opts...)
+
+	// Then
+	if len(d) != 0 || opts[:2][1] != nil {
+		t.Errorf("caller options were modified %v", d)
+	}
+}
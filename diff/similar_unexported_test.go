@@ -14,12 +14,13 @@ func Test_similar_invalid_values_diff(t *testing.T) {
 
 	// When
 
-	findSimilarityDiffs([]string{"a", "b", "c"}, va, vb, &diffs, map[similarVisit]bool{}, false)
+	findSimilarityDiffs(Path{FieldStep{"a"}, FieldStep{"b"}, FieldStep{"c"}}, va, vb, &diffs, newSimilarContext(nil))
 
 	// Then
 	if !reflect.DeepEqual(diffs, []Diff{
 		{
-			Path: []string{"a", "b", "c"},
+			Path:  []string{"[a]", "[b]", "[c]"},
+			Steps: Path{FieldStep{"a"}, FieldStep{"b"}, FieldStep{"c"}},
 			Value: InvalidDiff{
 				A: false,
 				B: true,
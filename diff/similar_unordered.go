@@ -0,0 +1,294 @@
+package diff
+
+import (
+	"reflect"
+	"strings"
+)
+
+// SimilarOption configures a SimilarWith comparison.
+type SimilarOption func(ctx *similarContext)
+
+// Unordered option ignores elements order of all slices and arrays.
+func Unordered() SimilarOption {
+	return func(ctx *similarContext) {
+		ctx.unordered = true
+	}
+}
+
+// UnorderedAt option ignores elements order of the slices and arrays found at the given JSONPath like paths.
+// Paths are made of field names and indexes (eg. "$.items", "$.items[*].tags", "$.lists[0]"),
+// "*" matching any field name or index.
+func UnorderedAt(paths ...string) SimilarOption {
+	return func(ctx *similarContext) {
+		for _, p := range paths {
+			ctx.unorderedAt = append(ctx.unorderedAt, parseJSONPath(p))
+		}
+	}
+}
+
+// Subset option only compares keys present on the b side (expectation) : extra a keys are ignored,
+// recursively for nested maps and structs.
+func Subset() SimilarOption {
+	return func(ctx *similarContext) {
+		ctx.subset = true
+	}
+}
+
+// SubsetArrays option matches slices and arrays as subsets : each b element (expectation) should be similar
+// to a distinct a element, regardless of order. Extra a elements are ignored.
+func SubsetArrays() SimilarOption {
+	return func(ctx *similarContext) {
+		ctx.subsetArrays = true
+	}
+}
+
+// SimilarSubset function is the SimilarWith function in Subset mode : only b keys are compared.
+func SimilarSubset(a, b interface{}, opts ...SimilarOption) (diffs []Diff) {
+	// Copied so that the caller opts backing array is never written
+	options := append(make([]SimilarOption, 0, len(opts)+1), opts...)
+	return SimilarWith(a, b, append(options, Subset())...)
+}
+
+type similarContext struct {
+	visited      map[similarVisit]bool
+	unordered    bool
+	unorderedAt  [][]string
+	subset       bool
+	subsetArrays bool
+	placeholders *Placeholders
+	captured     *Captures
+	// compared values count, bounding edit scripts cost (see editScriptMaxComparisons)
+	compared int
+	// deferred captures are only applied when the trial result is retained (see commit)
+	deferred bool
+	captures []func()
+}
+
+func newSimilarContext(opts []SimilarOption) *similarContext {
+	ctx := &similarContext{visited: make(map[similarVisit]bool)}
+	for _, opt := range opts {
+		opt(ctx)
+	}
+	return ctx
+}
+
+// trial returns a context sharing options but not visits, used to compare candidates without side effects.
+func (ctx *similarContext) trial() *similarContext {
+	return &similarContext{
+		visited:      make(map[similarVisit]bool),
+		unordered:    ctx.unordered,
+		unorderedAt:  ctx.unorderedAt,
+		subset:       ctx.subset,
+		subsetArrays: ctx.subsetArrays,
+		placeholders: ctx.placeholders,
+		captured:     ctx.captured,
+		deferred:     true,
+	}
+}
+
+// capture applies f (a Capture matcher assignment) or defers it on trial contexts.
+func (ctx *similarContext) capture(f func()) {
+	if ctx.deferred {
+		ctx.captures = append(ctx.captures, f)
+		return
+	}
+	f()
+}
+
+// commit applies the captures of a retained trial.
+func (ctx *similarContext) commit(trial *similarContext) {
+	for _, f := range trial.captures {
+		ctx.capture(f)
+	}
+}
+
+func (ctx *similarContext) isUnorderedAt(currentPath Path) bool {
+	if ctx.unordered {
+		return true
+	}
+	for _, pattern := range ctx.unorderedAt {
+		if pathMatches(pattern, currentPath) {
+			return true
+		}
+	}
+	return false
+}
+
+func parseJSONPath(p string) []string {
+	p = strings.TrimPrefix(p, "$")
+	segments := make([]string, 0)
+	for p != "" {
+		switch p[0] {
+		case '.':
+			p = p[1:]
+			end := strings.IndexAny(p, ".[")
+			if end < 0 {
+				end = len(p)
+			}
+			segments = append(segments, p[:end])
+			p = p[end:]
+		case '[':
+			end := strings.IndexByte(p, ']')
+			if end < 0 {
+				segments = append(segments, strings.Trim(p[1:], `'"`))
+				return segments
+			}
+			segments = append(segments, strings.Trim(p[1:end], `'"`))
+			p = p[end+1:]
+		default:
+			end := strings.IndexAny(p, ".[")
+			if end < 0 {
+				end = len(p)
+			}
+			segments = append(segments, p[:end])
+			p = p[end:]
+		}
+	}
+	return segments
+}
+
+func pathMatches(pattern []string, currentPath Path) bool {
+	if len(pattern) != len(currentPath) {
+		return false
+	}
+	for i, segment := range pattern {
+		token := strings.TrimSuffix(strings.TrimPrefix(legacyToken(currentPath[i]), "["), "]")
+		if segment != "*" && segment != token {
+			return false
+		}
+	}
+	return true
+}
+
+// checkUnorderedSimilarity compares two indexed values of the same length regardless of elements order.
+// Every pair of elements is compared, elements without dissimilarities are paired first (using a maximum
+// bipartite matching preferring same indexes), then each remaining A element is paired with its closest
+// B candidate (fewest dissimilarities) whose dissimilarities are reported under the A element path.
+func checkUnorderedSimilarity(currentPath Path, va, vb reflect.Value, length int, diffs *[]Diff,
+	ctx *similarContext) {
+	pairDiffs := make([][][]Diff, length)
+	pairCtx := make([][]*similarContext, length)
+	for i := 0; i < length; i++ {
+		pairDiffs[i] = make([][]Diff, length)
+		pairCtx[i] = make([]*similarContext, length)
+		iPath := append(currentPath, IndexStep{i})
+		for j := 0; j < length; j++ {
+			d := make([]Diff, 0)
+			pairCtx[i][j] = ctx.trial()
+			findSimilarityDiffs(iPath, va.Index(i), vb.Index(j), &d, pairCtx[i][j])
+			pairDiffs[i][j] = d
+		}
+	}
+
+	aMatch, bMatch := exactPairs(pairDiffs, length, length)
+	for i, j := range aMatch {
+		if j >= 0 {
+			ctx.commit(pairCtx[i][j])
+		}
+	}
+
+	for {
+		bestI, bestJ := -1, -1
+		for i := 0; i < length; i++ {
+			if aMatch[i] >= 0 {
+				continue
+			}
+			for j := 0; j < length; j++ {
+				if bMatch[j] >= 0 {
+					continue
+				}
+				if bestI < 0 || closerPair(pairDiffs, i, j, bestI, bestJ) {
+					bestI, bestJ = i, j
+				}
+			}
+		}
+		if bestI < 0 {
+			break
+		}
+		aMatch[bestI], bMatch[bestJ] = bestJ, bestI
+		ctx.commit(pairCtx[bestI][bestJ])
+		*diffs = append(*diffs, pairDiffs[bestI][bestJ]...)
+	}
+}
+
+func closerPair(pairDiffs [][][]Diff, i, j, bestI, bestJ int) bool {
+	l, bestL := len(pairDiffs[i][j]), len(pairDiffs[bestI][bestJ])
+	if l != bestL {
+		return l < bestL
+	}
+	return i == j && bestI != bestJ
+}
+
+// exactPairs pairs rows and columns whose pairDiffs are empty (maximum bipartite matching).
+func exactPairs(pairDiffs [][][]Diff, rows, cols int) ([]int, []int) {
+	aMatch := make([]int, rows)
+	bMatch := make([]int, cols)
+	for i := range aMatch {
+		aMatch[i] = -1
+	}
+	for j := range bMatch {
+		bMatch[j] = -1
+	}
+	var augment func(i int, seen []bool) bool
+	augment = func(i int, seen []bool) bool {
+		// Same index is tried first to keep natural pairs when several candidates are similar
+		for k := 0; k < cols; k++ {
+			j := (i + k) % cols
+			if seen[j] || len(pairDiffs[i][j]) > 0 {
+				continue
+			}
+			seen[j] = true
+			if bMatch[j] < 0 || augment(bMatch[j], seen) {
+				aMatch[i], bMatch[j] = j, i
+				return true
+			}
+		}
+		return false
+	}
+	for i := 0; i < rows; i++ {
+		augment(i, make([]bool, cols))
+	}
+	return aMatch, bMatch
+}
+
+// checkSubsetSimilarity checks that each B element is similar to a distinct A element, regardless of order.
+// Unpaired B elements are reported with the dissimilarities of their closest remaining A candidate,
+// or as ElementNotFoundDiff when no A candidate remains.
+func checkSubsetSimilarity(currentPath Path, va, vb reflect.Value, lenA, lenB int, diffs *[]Diff,
+	ctx *similarContext) {
+	pairDiffs := make([][][]Diff, lenB)
+	pairCtx := make([][]*similarContext, lenB)
+	for j := 0; j < lenB; j++ {
+		pairDiffs[j] = make([][]Diff, lenA)
+		pairCtx[j] = make([]*similarContext, lenA)
+		for i := 0; i < lenA; i++ {
+			d := make([]Diff, 0)
+			pairCtx[j][i] = ctx.trial()
+			findSimilarityDiffs(append(currentPath, IndexStep{i}), va.Index(i), vb.Index(j), &d, pairCtx[j][i])
+			pairDiffs[j][i] = d
+		}
+	}
+
+	bMatch, aMatch := exactPairs(pairDiffs, lenB, lenA)
+
+	for j := 0; j < lenB; j++ {
+		if bMatch[j] >= 0 {
+			ctx.commit(pairCtx[j][bMatch[j]])
+			continue
+		}
+		best := -1
+		for i := 0; i < lenA; i++ {
+			if aMatch[i] < 0 && (best < 0 || len(pairDiffs[j][i]) < len(pairDiffs[j][best])) {
+				best = i
+			}
+		}
+		if best < 0 {
+			*diffs = append(*diffs, newDiff(append(currentPath, IndexStep{j}),
+				ElementNotFoundDiff{interfaceOf(vb.Index(j))}))
+			continue
+		}
+		aMatch[best], bMatch[j] = j, best
+		ctx.commit(pairCtx[j][best])
+		*diffs = append(*diffs, pairDiffs[j][best]...)
+	}
+}
@@ -10,16 +10,17 @@ func Test_invalid_values_diff(t *testing.T) {
 
 	va := reflect.Value{}
 	vb := reflect.ValueOf(3)
-	diffs := make([]Diff, 0)
+	ctx := newDiffContext(nil)
 
 	// When
 
-	findDiffs([]string{"a", "b", "c"}, va, vb, &diffs, map[visit]bool{})
+	findDiffs(Path{FieldStep{"a"}, FieldStep{"b"}, FieldStep{"c"}}, va, vb, ctx)
 
 	// Then
-	if !reflect.DeepEqual(diffs, []Diff{
+	if !reflect.DeepEqual(ctx.diffs, []Diff{
 		{
-			Path: []string{"a", "b", "c"},
+			Path:  []string{"[a]", "[b]", "[c]"},
+			Steps: Path{FieldStep{"a"}, FieldStep{"b"}, FieldStep{"c"}},
 			Value: InvalidDiff{
 				A: false,
 				B: true,
@@ -7,17 +7,20 @@ import (
 
 // Diff is a structure pointing a difference on a variable Path (Deep navigation on attributes and indexes).
 // A difference is reported in the Value attribute as un error.
+// Steps is the typed path (see Path and Step) of which Path holds the tokens.
 type Diff struct {
 	Path  []string
 	Value error
+	Steps Path
 }
 
-func newDiff(path []string, value error) Diff {
-	cPath := make([]string, len(path))
+func newDiff(path Path, value error) Diff {
+	cPath := make(Path, len(path))
 	copy(cPath, path)
 	return Diff{
-		Path:  cPath,
+		Path:  cPath.Strings(),
 		Value: value,
+		Steps: cPath,
 	}
 }
 
@@ -76,31 +79,86 @@ func (id InvalidDiff) Error() string {
 	return "invalid value"
 }
 
+// ElementNotFoundDiff reports that an expected (B) element was not found in A (see SubsetArrays option).
+type ElementNotFoundDiff struct {
+	Value interface{}
+}
+
+func (ed ElementNotFoundDiff) Error() string {
+	return fmt.Sprintf("element not found : %v", ed.Value)
+}
+
+// PlaceholderDiff reports an expectation placeholder that cannot be resolved (see Placeholders).
+type PlaceholderDiff struct {
+	Placeholder string
+	Err         error
+}
+
+func (pd PlaceholderDiff) Error() string {
+	return fmt.Sprintf("invalid placeholder %s : %v", pd.Placeholder, pd.Err)
+}
+
+// DeletedDiff reports an A element (at its A index) missing from B (see Diffs and Similar edit scripts).
+type DeletedDiff struct {
+	Value interface{}
+}
+
+func (dd DeletedDiff) Error() string {
+	return fmt.Sprintf("element deleted : %v", dd.Value)
+}
+
+// InsertedDiff reports a B element (at its B index) missing from A (see Diffs and Similar edit scripts).
+type InsertedDiff struct {
+	Value interface{}
+}
+
+func (id InsertedDiff) Error() string {
+	return fmt.Sprintf("element inserted : %v", id.Value)
+}
+
+// UnsupportedDiff reports two values of a Kind that cannot be compared (eg. non comparable unexported values).
+type UnsupportedDiff struct {
+	CommonDiff
+	Kind reflect.Kind
+}
+
+func (ud UnsupportedDiff) Error() string {
+	return fmt.Sprintf("values of kind %v cannot be compared", ud.Kind)
+}
+
 // Diffs function returns all extracted differences between to variables a and b.
 // It copies most of the standard reflect.DeepEq algorithm (getting around some unexported capabilities).
 func Diffs(a, b interface{}) (diffs []Diff) {
-	diffs = make([]Diff, 0)
-	path := make([]string, 0)
-	if a == nil && b == nil {
-		return diffs
-	}
+	diffs, _ = DiffsWith(a, b)
+	return diffs
+}
 
-	if a == nil {
-		diffs = append(diffs, Diff{Path: path, Value: CommonDiff{a, b}})
-		return diffs
+// DiffsWith function is the Diffs function configured by options (see MaxDiffs, MaxDepth and StopAtFirst).
+// It also returns the comparison Stats.
+func DiffsWith(a, b interface{}, opts ...DiffOption) ([]Diff, Stats) {
+	ctx := newDiffContext(opts)
+	path := make(Path, 0)
+	if a == nil && b == nil {
+		return ctx.diffs, ctx.stats
 	}
 
-	if b == nil {
-		diffs = append(diffs, Diff{Path: path, Value: CommonDiff{a, b}})
-		return diffs
+	if a == nil || b == nil {
+		ctx.stats.Compared++
+		ctx.add(newDiff(path, CommonDiff{a, b}))
+		return ctx.diffs, ctx.stats
 	}
 
-	va := reflect.ValueOf(a)
-	vb := reflect.ValueOf(b)
+	findDiffs(path, reflect.ValueOf(a), reflect.ValueOf(b), ctx)
 
-	findDiffs(path, va, vb, &diffs, make(map[visit]bool))
+	return ctx.diffs, ctx.stats
+}
 
-	return diffs
+// Equal function reports whether Diffs finds no difference between a and b, stopping at the first one.
+func Equal(a, b interface{}, opts ...DiffOption) bool {
+	// Copied so that the caller opts backing array is never written
+	options := append(make([]DiffOption, 0, len(opts)+1), opts...)
+	diffs, _ := DiffsWith(a, b, append(options, StopAtFirst())...)
+	return len(diffs) == 0
 }
 
 type visit struct {
@@ -150,36 +208,35 @@ const (
 	noNil
 )
 
-func checkNilValue(va, vb reflect.Value, currentPath []string, diffs *[]Diff) int {
+func checkNilValue(va, vb reflect.Value, currentPath Path, ctx *diffContext) int {
 	if va.IsNil() && vb.IsNil() {
 		return areNil
 	}
 	if va.IsNil() {
-		*diffs = append(*diffs, newDiff(currentPath, CommonDiff{nil, vb.Interface()}))
+		ctx.add(newDiff(currentPath, CommonDiff{nil, vb.Interface()}))
 		return aNil
 	}
 	if vb.IsNil() {
-		*diffs = append(*diffs, newDiff(currentPath, CommonDiff{va.Interface(), nil}))
+		ctx.add(newDiff(currentPath, CommonDiff{va.Interface(), nil}))
 		return bNil
 	}
 	return noNil
 }
 
-func simpleEqDiff(a, b interface{}, currentPath []string, diffs *[]Diff) {
+func simpleEqDiff(a, b interface{}, currentPath Path, diffs *[]Diff) {
 	if a != b {
 		*diffs = append(*diffs, newDiff(currentPath, CommonDiff{a, b}))
 	}
 }
 
-func checkArrays(currentPath []string, va, vb reflect.Value, diffs *[]Diff, visited map[visit]bool) {
-	for i := 0; i < va.Len(); i++ {
-		iKey := fmt.Sprintf("[%d]", i)
-		findDiffs(append(currentPath, iKey), va.Index(i), vb.Index(i), diffs, visited)
+func checkArrays(currentPath Path, va, vb reflect.Value, ctx *diffContext) {
+	for i := 0; i < va.Len() && !ctx.interrupted(); i++ {
+		findDiffs(append(currentPath, IndexStep{i}), va.Index(i), vb.Index(i), ctx)
 	}
 }
 
-func checkSlices(currentPath []string, va, vb reflect.Value, diffs *[]Diff, visited map[visit]bool) {
-	if checkNilValue(va, vb, currentPath, diffs) != noNil {
+func checkSlices(currentPath Path, va, vb reflect.Value, ctx *diffContext) {
+	if checkNilValue(va, vb, currentPath, ctx) != noNil {
 		return
 	}
 	if va.Pointer() == vb.Pointer() {
@@ -187,18 +244,42 @@ func checkSlices(currentPath []string, va, vb reflect.Value, diffs *[]Diff, visi
 	}
 	lenVa := va.Len()
 	if lenDiff := lenVa - vb.Len(); lenDiff != 0 {
-		*diffs = append(*diffs, newDiff(currentPath, LenDiff{CommonDiff{va.Interface(), vb.Interface()}, lenDiff}))
+		if !ctx.detailed() || !checkSlicesEditScript(currentPath, va, vb, ctx) {
+			ctx.add(newDiff(currentPath, LenDiff{CommonDiff{va.Interface(), vb.Interface()}, lenDiff}))
+		}
 		return
 	}
 
-	for i := 0; i < lenVa; i++ {
-		iKey := fmt.Sprintf("[%d]", i)
-		findDiffs(append(currentPath, iKey), va.Index(i), vb.Index(i), diffs, visited)
+	for i := 0; i < lenVa && !ctx.interrupted(); i++ {
+		findDiffs(append(currentPath, IndexStep{i}), va.Index(i), vb.Index(i), ctx)
 	}
 }
 
-func checkMaps(currentPath []string, va, vb reflect.Value, diffs *[]Diff, visited map[visit]bool) {
-	if checkNilValue(va, vb, currentPath, diffs) != noNil {
+// checkSlicesEditScript reports the edit script of two slices of different lengths (see editScript) :
+// modified elements are compared, others are reported as DeletedDiff or InsertedDiff.
+// It returns false when the script is too costly. Element comparisons are counted in Stats.
+func checkSlicesEditScript(currentPath Path, va, vb reflect.Value, ctx *diffContext) bool {
+	ops, ok := editScript(va.Len(), vb.Len(), func(i, j int) (bool, int) {
+		sub := &diffContext{visited: make(map[visit]bool), stopAtFirst: true, diffs: make([]Diff, 0)}
+		findDiffs(currentPath, va.Index(i), vb.Index(j), sub)
+		ctx.stats.Compared += sub.stats.Compared
+		return len(sub.diffs) == 0, sub.stats.Compared
+	})
+	if !ok {
+		return false
+	}
+	editHunks(ops, func(i, j int) {}, func(i, j int) {
+		findDiffs(append(currentPath, IndexStep{i}), va.Index(i), vb.Index(j), ctx)
+	}, func(i int) {
+		ctx.add(newDiff(append(currentPath, IndexStep{i}), DeletedDiff{interfaceOf(va.Index(i))}))
+	}, func(j int) {
+		ctx.add(newDiff(append(currentPath, IndexStep{j}), InsertedDiff{interfaceOf(vb.Index(j))}))
+	})
+	return true
+}
+
+func checkMaps(currentPath Path, va, vb reflect.Value, ctx *diffContext) {
+	if checkNilValue(va, vb, currentPath, ctx) != noNil {
 		return
 	}
 	if va.Pointer() == vb.Pointer() {
@@ -206,100 +287,115 @@ func checkMaps(currentPath []string, va, vb reflect.Value, diffs *[]Diff, visite
 	}
 	lenVa := va.Len()
 	if lenDiff := lenVa - vb.Len(); lenDiff != 0 {
-		*diffs = append(*diffs, newDiff(currentPath, LenDiff{CommonDiff{va.Interface(), vb.Interface()}, lenDiff}))
+		ctx.add(newDiff(currentPath, LenDiff{CommonDiff{va.Interface(), vb.Interface()}, lenDiff}))
 		return
 	}
-	for _, k := range va.MapKeys() {
-		fieldName := fmt.Sprintf("[%v]", k)
+	for _, k := range sortedMapKeys(va) {
+		if ctx.interrupted() {
+			return
+		}
+		fieldName := MapKeyStep{interfaceOf(k)}
 		bValue := vb.MapIndex(k)
 		if !bValue.IsValid() || bValue.IsZero() {
-			*diffs = append(*diffs, newDiff(append(currentPath, fieldName),
+			ctx.add(newDiff(append(currentPath, fieldName),
 				KeyNotFoundDiff{Key: fmt.Sprintf("%v", k), A: true, B: false}))
 		} else {
-			findDiffs(append(currentPath, fieldName), va.MapIndex(k), bValue, diffs, visited)
+			findDiffs(append(currentPath, fieldName), va.MapIndex(k), bValue, ctx)
 		}
 	}
-	for _, k := range vb.MapKeys() {
-		fieldName := fmt.Sprintf("[%v]", k)
+	for _, k := range sortedMapKeys(vb) {
+		if ctx.interrupted() {
+			return
+		}
+		fieldName := MapKeyStep{interfaceOf(k)}
 		aValue := va.MapIndex(k)
 		if !aValue.IsValid() || aValue.IsZero() {
-			*diffs = append(*diffs, newDiff(append(currentPath, fieldName),
+			ctx.add(newDiff(append(currentPath, fieldName),
 				KeyNotFoundDiff{Key: fmt.Sprintf("%v", k), A: false, B: true}))
 		}
 	}
 }
 
-func checkStructs(currentPath []string, va, vb reflect.Value, diffs *[]Diff, visited map[visit]bool) {
+func checkStructs(currentPath Path, va, vb reflect.Value, ctx *diffContext) {
 	t := va.Type()
 	nbFields := t.NumField()
-	for i := 0; i < nbFields; i++ {
+	for i := 0; i < nbFields && !ctx.interrupted(); i++ {
 		fName := t.Field(i).Name
-		ffName := fmt.Sprintf("[%s]", fName)
-		findDiffs(append(currentPath, ffName), va.FieldByName(fName), vb.FieldByName(fName), diffs, visited)
+		findDiffs(append(currentPath, FieldStep{fName}), va.FieldByName(fName), vb.FieldByName(fName), ctx)
 	}
 }
 
-func findDiffs(currentPath []string, va, vb reflect.Value, diffs *[]Diff, visited map[visit]bool) {
+// nolint:gocyclo
+func findDiffs(currentPath Path, va, vb reflect.Value, ctx *diffContext) {
+	if ctx.interrupted() {
+		return
+	}
+	ctx.stats.Compared++
 	if !va.IsValid() || !vb.IsValid() {
-		*diffs = append(*diffs, newDiff(currentPath, InvalidDiff{va.IsValid(), vb.IsValid()}))
+		ctx.add(newDiff(currentPath, InvalidDiff{va.IsValid(), vb.IsValid()}))
 		return
 	}
 	ta, tb := va.Type(), vb.Type()
 	if ta != tb {
-		*diffs = append(*diffs, newDiff(currentPath, TypeDiff{va.Interface(), vb.Interface()}))
+		ctx.add(newDiff(currentPath, TypeDiff{va.Interface(), vb.Interface()}))
+		return
+	}
+
+	if checkVisited(va, vb, ta, ctx.visited) {
 		return
 	}
 
-	if checkVisited(va, vb, ta, visited) {
+	if ctx.tooDeep(currentPath, va.Kind()) {
+		checkSummarized(currentPath, va, vb, ctx)
 		return
 	}
 
 	switch va.Kind() {
 	case reflect.Array:
 		// Array len is part of the Type()
-		checkArrays(currentPath, va, vb, diffs, visited)
+		checkArrays(currentPath, va, vb, ctx)
 	case reflect.Slice:
-		checkSlices(currentPath, va, vb, diffs, visited)
+		checkSlices(currentPath, va, vb, ctx)
 	case reflect.Interface:
-		if checkNilValue(va, vb, currentPath, diffs) != noNil {
+		if checkNilValue(va, vb, currentPath, ctx) != noNil {
 			return
 		}
-		findDiffs(append(currentPath, "[interface{}]"), va.Elem(), vb.Elem(), diffs, visited)
+		findDiffs(append(currentPath, InterfaceStep{va.Elem().Type()}), va.Elem(), vb.Elem(), ctx)
 	case reflect.Ptr:
 		if va.Pointer() == vb.Pointer() {
 			return
 		}
-		if checkNilValue(va, vb, currentPath, diffs) != noNil {
+		if checkNilValue(va, vb, currentPath, ctx) != noNil {
 			return
 		}
-		findDiffs(append(currentPath, "[&]"), va.Elem(), vb.Elem(), diffs, visited)
+		findDiffs(append(currentPath, DerefStep{}), va.Elem(), vb.Elem(), ctx)
 	case reflect.Struct:
-		checkStructs(currentPath, va, vb, diffs, visited)
+		checkStructs(currentPath, va, vb, ctx)
 	case reflect.Map:
-		checkMaps(currentPath, va, vb, diffs, visited)
+		checkMaps(currentPath, va, vb, ctx)
 	case reflect.Func:
-		if checkNilValue(va, vb, currentPath, diffs) != noNil {
+		if checkNilValue(va, vb, currentPath, ctx) != noNil {
 			return
 		}
 		// Can't do better than this:
-		*diffs = append(*diffs, newDiff(currentPath, FuncDiff{va.Interface(), vb.Interface()}))
+		ctx.add(newDiff(currentPath, FuncDiff{va.Interface(), vb.Interface()}))
 	// Note: since Value.Interface() do not return unexported attributes
 	// Continue case by case
 	case reflect.Bool:
-		simpleEqDiff(va.Bool(), vb.Bool(), currentPath, diffs)
+		ctx.eq(va.Bool(), vb.Bool(), currentPath)
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		simpleEqDiff(va.Int(), vb.Int(), currentPath, diffs)
+		ctx.eq(va.Int(), vb.Int(), currentPath)
 	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
-		simpleEqDiff(va.Uint(), vb.Uint(), currentPath, diffs)
+		ctx.eq(va.Uint(), vb.Uint(), currentPath)
 	case reflect.Float32, reflect.Float64:
-		simpleEqDiff(va.Float(), vb.Float(), currentPath, diffs)
+		ctx.eq(va.Float(), vb.Float(), currentPath)
 	case reflect.Complex64, reflect.Complex128:
-		simpleEqDiff(va.Complex(), vb.Complex(), currentPath, diffs)
+		ctx.eq(va.Complex(), vb.Complex(), currentPath)
 	case reflect.UnsafePointer:
-		simpleEqDiff(va.Interface(), vb.Interface(), currentPath, diffs)
+		ctx.eq(va.Interface(), vb.Interface(), currentPath)
 	case reflect.String:
-		simpleEqDiff(va.String(), vb.String(), currentPath, diffs)
+		ctx.eq(va.String(), vb.String(), currentPath)
 	case reflect.Chan:
-		simpleEqDiff(va.Interface(), vb.Interface(), currentPath, diffs)
+		ctx.eq(va.Interface(), vb.Interface(), currentPath)
 	}
 }
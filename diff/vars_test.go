@@ -74,12 +74,9 @@ func Test_simple_one_level_differences(t *testing.T) {
 			result: rootLevelDiffs(diff.TypeDiff{A: [3]string{"a", "b", "c"}, B: [2]string{"a", "b"}}),
 		},
 		{
-			a: []string{"a", "b"},
-			b: []string{"a", "b", "c"},
-			result: rootLevelDiffs(diff.LenDiff{
-				CommonDiff: diff.CommonDiff{A: []string{"a", "b"}, B: []string{"a", "b", "c"}},
-				Value:      -1,
-			}),
+			a:      []string{"a", "b"},
+			b:      []string{"a", "b", "c"},
+			result: []diff.Diff{{Path: []string{"[2]"}, Value: diff.InsertedDiff{Value: "c"}}},
 		},
 		{
 			a: map[int]string{1: "a", 2: "b", 3: "c"},
@@ -130,7 +127,7 @@ func Test_simple_one_level_differences(t *testing.T) {
 		// When
 		d := diff.Diffs(tc.a, tc.b)
 		// Then
-		if !reflect.DeepEqual(d, tc.result) {
+		if !reflect.DeepEqual(withoutSteps(d), tc.result) {
 			t.Fail()
 		}
 	}
@@ -201,7 +198,7 @@ func Test_simple_second_level_differences(t *testing.T) {
 		// When
 		d := diff.Diffs(tc.a, tc.b)
 		// Then
-		if !reflect.DeepEqual(d, tc.result) {
+		if !reflect.DeepEqual(withoutSteps(d), tc.result) {
 			t.Fail()
 		}
 	}
@@ -314,7 +311,7 @@ func Test_multi_level_differences(t *testing.T) {
 		// When
 		d := diff.Diffs(tc.a, tc.b)
 		// Then
-		if !reflect.DeepEqual(d, tc.result) {
+		if !reflect.DeepEqual(withoutSteps(d), tc.result) {
 			t.Fail()
 		}
 	}